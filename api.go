@@ -5,60 +5,328 @@ import (
 	"strings"
 )
 
+//Persistence persistence of a table
+type Persistence string
+
+//persistences of table
+const (
+	PersistencePermanent Persistence = ""
+	PersistenceTemporary Persistence = "TEMPORARY"
+	PersistenceUnlogged  Persistence = "UNLOGGED"
+)
+
+//OnCommitAction what happens to a temporary table at the end of a transaction
+type OnCommitAction string
+
+//actions of ON COMMIT, OnCommitNone if the clause is omitted
+const (
+	OnCommitNone         OnCommitAction = ""
+	OnCommitPreserveRows OnCommitAction = "PRESERVE ROWS"
+	OnCommitDeleteRows   OnCommitAction = "DELETE ROWS"
+	OnCommitDrop         OnCommitAction = "DROP"
+)
+
 //TableDefine define of a table
 type TableDefine struct {
-	Schema     string
-	Table      string
-	Columns    []*TableColumn
-	Constraint *TableConstraint
+	Schema      string
+	Table       string
+	Persistence Persistence
+	IfNotExists bool
+	OnCommit    OnCommitAction
+	With        StorageParameters // storage parameters given by WITH, nil if omitted
+	Tablespace  string            // tablespace given by TABLESPACE, empty if omitted
+	Columns     []*TableColumn
+	Constraint  *TableConstraint
+	Comment     string // leading and trailing comments of the statement
+
+	PartitionBy *PartitionSpec  // nil if the table is not partitioned
+	PartitionOf *PartitionBound // nil if the table is not a partition
+	Parent      *TableDefine    `json:"-"` // parent of a partition, nil if the parent is not in the same input, skipped by JSON which loops on it
+	Partitions  []*TableDefine  // partitions of the table in the same input
+	Inherits    []TableName     // parents given by INHERITS
+	Likes       []*LikeClause
+
+	OfType       *TableName // composite type of a typed table given by OF, nil if the table is not typed
+	TypeResolved bool       // the columns come from the type declared before in the same input, otherwise they have no DataType
+
+	Query *TableQuery // query of CREATE TABLE AS, SELECT INTO and CREATE MATERIALIZED VIEW, nil for other tables
+
+	Foreign bool           // the table is created by CREATE FOREIGN TABLE
+	Server  string         // foreign server given by SERVER
+	Options GenericOptions // options of the foreign table given by OPTIONS, nil if omitted
+
+	key            TableName      // Schema and Table as postgres looks them up
+	inheritKeys    []TableName    // Inherits as postgres looks them up
+	ofTypeKey      TableName      // OfType as postgres looks it up
+	inheritParents []*TableDefine // defines of Inherits, nil for a parent not in the same input
+	pos            Pos
 }
 
 //TableColumn one column define in a table
 type TableColumn struct {
-	Name string
-	Type string
-	//Collation string
-	Nullable bool
-	//Default  string
+	Name            string
+	Type            string
+	CanonicalType   string
+	DataType        *DataType
+	Collation       string // collation given by COLLATE, empty for the default collation
+	CollationSchema string // schema of the collation, e.g. pg_catalog in COLLATE pg_catalog."default"
+	Nullable        bool
+	Default         string // source text of the DEFAULT expression
+	DefaultExpr     Expr
+	Identity        *Identity // nil if the column is not an identity column
+	Generated       string    // source text of the GENERATED ALWAYS AS expression of a generated column
+	GeneratedExpr   Expr
+	GeneratedStored bool
+	Options         GenericOptions // options of a foreign table column, nil if omitted
+	Comment         string         // leading and trailing comments of the column define
+
+	pos Pos
 }
 
 //TableConstraint constraint in table include constraint in column
 type TableConstraint struct {
-	PrimaryKey []string
-	Uniques    [][]string
+	PrimaryKey  *PrimaryKey // nil if the table has no primary key
+	Uniques     []*Unique
+	ForeignKeys []*ForeignKey
+	Checks      []*Check
+	Exclusions  []*Exclusion
+	NotNulls    []*NotNull
 }
 
 func combineConstraint(c1, c2 TableConstraint) TableConstraint {
+	primaryKey := c1.PrimaryKey
+	if c2.PrimaryKey != nil {
+		primaryKey = c2.PrimaryKey
+	}
 	return TableConstraint{
-		PrimaryKey: append(c1.PrimaryKey, c2.PrimaryKey...),
-		Uniques:    append(c1.Uniques, c2.Uniques...),
+		PrimaryKey:  primaryKey,
+		Uniques:     append(c1.Uniques, c2.Uniques...),
+		ForeignKeys: append(c1.ForeignKeys, c2.ForeignKeys...),
+		Checks:      append(c1.Checks, c2.Checks...),
+		Exclusions:  append(c1.Exclusions, c2.Exclusions...),
+		NotNulls:    append(c1.NotNulls, c2.NotNulls...),
 	}
 }
 
 type columnObj struct {
+	pos        Pos
 	Name       string
-	Type       string
-	PrimaryKey bool
-	Unique     bool
-	NotNull    bool
+	DataType   *DataType
+	PrimaryKey *PrimaryKey
+	Uniques    []*Unique
+	NotNull    *NotNull
+	Null       bool // an explicit NULL is declared
+
+	Default     string
+	DefaultExpr Expr
+	ForeignKeys []*ForeignKey
+	Checks      []*Check
+
+	Identity        *Identity
+	Generated       string
+	GeneratedExpr   Expr
+	GeneratedStored bool
+
+	Collation       string
+	CollationSchema string
+
+	Options GenericOptions
+
+	constraintName string // name given by CONSTRAINT to the next constraint
+	constraintPos  Pos
+	attrs          *constraintAttributes // attributes of the last constraint, nil if it can not be deferred
+	nullPos        Pos
+	notNullPos     Pos
+	repeatPos      Pos
+	defaultPos     Pos
+	identityPos    Pos
+	generatedPos   Pos
+	repeated       string // the first clause given twice, empty if none
+}
+
+//repeat record a clause given again at pos, only the first one is reported
+func (o *columnObj) repeat(pos Pos, clause string) {
+	if o.repeated == "" {
+		o.repeated = clause
+		o.repeatPos = pos
+	}
+}
+
+//nextConstraint start the next constraint of the column,
+//return the name given to it by CONSTRAINT, empty if it is not named
+func (o *columnObj) nextConstraint() string {
+	name := o.constraintName
+	o.constraintName = ""
+	o.attrs = nil
+	return name
 }
 
 func (o columnObj) Column() *TableColumn {
-	return &TableColumn{
-		Name:     o.Name,
-		Type:     o.Type,
-		Nullable: !o.NotNull,
+	column := &TableColumn{
+		pos:             o.pos,
+		Name:            o.Name,
+		DataType:        o.DataType,
+		Collation:       o.Collation,
+		CollationSchema: o.CollationSchema,
+		Nullable:        o.NotNull == nil && o.Identity == nil,
+		Default:         o.Default,
+		DefaultExpr:     o.DefaultExpr,
+		Identity:        o.Identity,
+		Generated:       o.Generated,
+		GeneratedExpr:   o.GeneratedExpr,
+		GeneratedStored: o.GeneratedStored,
+		Options:         o.Options,
+	}
+	// the column of a partition only adds options, its type comes from the parent
+	if o.DataType != nil {
+		column.Type = o.DataType.String()
+		column.CanonicalType = o.DataType.Canonical()
 	}
+	return column
 }
 
-type tableHeader struct {
+//Insertable whether INSERT can give a value to the column, false for generated columns
+//and GENERATED ALWAYS identity columns
+func (c *TableColumn) Insertable() bool {
+	if c.GeneratedExpr != nil {
+		return false
+	}
+	return c.Identity == nil || !c.Identity.Always
+}
+
+//Sequence name of the sequence which fills the column by a nextval() DEFAULT
+func (c *TableColumn) Sequence() (string, bool) {
+	if c.DefaultExpr == nil {
+		return "", false
+	}
+	return NextvalSequence(c.DefaultExpr)
+}
+
+//qualifiedName name of an object which may be qualified by a schema, like a collation
+type qualifiedName struct {
 	Schema string
-	Table  string
+	Name   string
+}
+
+func (n qualifiedName) String() string {
+	if n.Schema == "" {
+		return n.Name
+	}
+	return fmt.Sprintf("%s.%s", n.Schema, n.Name)
+}
+
+type tableHeader struct {
+	Schema      string
+	Table       string
+	Persistence Persistence
+	IfNotExists bool
+
+	key TableName // Schema and Table as postgres looks them up
+}
+
+type tableOptions struct {
+	partitionBy *PartitionSpec
+	with        StorageParameters
+	tablespace  string
+	onCommit    OnCommitAction
+	onCommitPos Pos
 }
 
 type tableBody struct {
-	columns    []columnObj
-	constraint TableConstraint
+	columns     []columnObj
+	constraint  TableConstraint
+	primaryKeys []Pos // positions of the primary key declarations
+	likes       []*LikeClause
+}
+
+//addColumn add a column and move its constraints into the table constraint,
+//constraints are kept in the order they are declared so they are named like postgres does
+func (b *tableBody) addColumn(obj columnObj) {
+	b.columns = append(b.columns, obj)
+	if obj.PrimaryKey != nil {
+		obj.PrimaryKey.Columns = []string{obj.Name}
+		b.constraint.PrimaryKey = obj.PrimaryKey
+		b.primaryKeys = append(b.primaryKeys, obj.pos)
+	}
+	for _, unique := range obj.Uniques {
+		unique.Columns = []string{obj.Name}
+		b.constraint.Uniques = append(b.constraint.Uniques, unique)
+	}
+	if obj.NotNull != nil {
+		obj.NotNull.Column = obj.Name
+		b.constraint.NotNulls = append(b.constraint.NotNulls, obj.NotNull)
+	}
+	b.constraint.Checks = append(b.constraint.Checks, obj.Checks...)
+	for _, fk := range obj.ForeignKeys {
+		fk.Columns = []string{obj.Name}
+		b.constraint.ForeignKeys = append(b.constraint.ForeignKeys, fk)
+	}
+}
+
+//addConstraint add a table constraint declared at pos
+func (b *tableBody) addConstraint(pos Pos, constraint TableConstraint) {
+	b.constraint = combineConstraint(b.constraint, constraint)
+	if constraint.PrimaryKey != nil {
+		b.primaryKeys = append(b.primaryKeys, pos)
+	}
+}
+
+//laterPos the later one of two clauses, where a conflict between them is found
+func laterPos(p1, p2 Pos) Pos {
+	if p2 > p1 {
+		return p2
+	}
+	return p1
+}
+
+//newTableDefine build a table define from the parts of a CREATE TABLE statement
+func (l *lexer) newTableDefine(pos Pos, header tableHeader, body tableBody, options tableOptions) *TableDefine {
+	if options.onCommit != OnCommitNone && header.Persistence != PersistenceTemporary {
+		l.errorAt(options.onCommitPos, "ON COMMIT can only be used on temporary tables")
+	}
+	if len(body.primaryKeys) > 1 {
+		l.errorAt(body.primaryKeys[1], "multiple primary keys for table %q are not allowed", header.Table)
+	}
+	columns := []*TableColumn{}
+	constraint := body.constraint
+	for _, obj := range body.columns {
+		if obj.Null && (obj.NotNull != nil || obj.Identity != nil) {
+			l.errorAt(laterPos(obj.nullPos, obj.notNullPos), "conflicting NULL/NOT NULL declarations for column %q of table %q", obj.Name, header.Table)
+		}
+		switch obj.repeated {
+		case "PRIMARY KEY":
+			l.errorAt(obj.repeatPos, "multiple primary keys for table %q are not allowed", header.Table)
+		case "DEFAULT":
+			l.errorAt(obj.repeatPos, "multiple default values specified for column %q of table %q", obj.Name, header.Table)
+		case "NULL", "NOT NULL":
+			l.errorAt(obj.repeatPos, "multiple %s declarations for column %q of table %q", obj.repeated, obj.Name, header.Table)
+		}
+		switch {
+		case obj.DefaultExpr != nil && obj.Identity != nil:
+			l.errorAt(laterPos(obj.defaultPos, obj.identityPos), "both default and identity specified for column %q of table %q", obj.Name, header.Table)
+		case obj.DefaultExpr != nil && obj.GeneratedExpr != nil:
+			l.errorAt(laterPos(obj.defaultPos, obj.generatedPos), "both default and generation expression specified for column %q of table %q", obj.Name, header.Table)
+		case obj.Identity != nil && obj.GeneratedExpr != nil:
+			l.errorAt(laterPos(obj.identityPos, obj.generatedPos), "both identity and generation expression specified for column %q of table %q", obj.Name, header.Table)
+		}
+		columns = append(columns, obj.Column())
+	}
+	constraint.nameConstraints(header.Table)
+	return &TableDefine{
+		pos:         pos,
+		key:         header.key,
+		Schema:      header.Schema,
+		Table:       header.Table,
+		Persistence: header.Persistence,
+		IfNotExists: header.IfNotExists,
+		OnCommit:    options.onCommit,
+		PartitionBy: options.partitionBy,
+		With:        options.with,
+		Tablespace:  options.tablespace,
+		Likes:       body.likes,
+		Columns:     columns,
+		Constraint:  &constraint,
+	}
 }
 
 //ParseTable parse a giving create table statement,get a table define struct
@@ -66,9 +334,17 @@ func ParseTable(name, sql string) ([]*TableDefine, error) {
 	yyErrorVerbose = true
 	l := lex(name, sql)
 	p := &yyParserImpl{}
-	if p.Parse(l) != 0 {
+	if p.Parse(l) != 0 || l.lerror != nil {
 		return nil, l.lerror
 	}
+	attachComments(l.ast, l.scanned)
+	if err := resolveTypedTables(l.ast, l.types); err != nil {
+		return nil, err
+	}
+	linkParents(l.ast)
+	if err := expandLikes(l.ast); err != nil {
+		return nil, err
+	}
 	return l.ast, nil
 }
 
@@ -80,13 +356,23 @@ func Define2String(def *TableDefine) string {
 	}
 	constraints := "\n\tConstraints:"
 	if def.Constraint != nil {
-		if len(def.Constraint.PrimaryKey) > 0 {
-			constraints += fmt.Sprintf("\n\t\tPK: %s", strings.Join(def.Constraint.PrimaryKey, ","))
+		if pk := def.Constraint.PrimaryKey; pk != nil {
+			constraints += fmt.Sprintf("\n\t\tPK %s: %s", pk.Name, strings.Join(pk.Columns, ","))
+		}
+		for _, unique := range def.Constraint.Uniques {
+			constraints += fmt.Sprintf("\n\t\tUnique %s: (%s)", unique.Name, strings.Join(unique.Columns, ","))
+		}
+		for _, fk := range def.Constraint.ForeignKeys {
+			constraints += fmt.Sprintf("\n\t\tFK %s: %s", fk.Name, fk)
+		}
+		for _, check := range def.Constraint.Checks {
+			constraints += fmt.Sprintf("\n\t\tCheck %s: %s", check.Name, check)
+		}
+		for _, exclusion := range def.Constraint.Exclusions {
+			constraints += fmt.Sprintf("\n\t\tExclusion %s: %s", exclusion.Name, exclusion)
 		}
-		if len(def.Constraint.Uniques) > 0 {
-			for _, unique := range def.Constraint.Uniques {
-				constraints += fmt.Sprintf("\n\t\tUnique: (%s)", strings.Join(unique, ","))
-			}
+		for _, notNull := range def.Constraint.NotNulls {
+			constraints += fmt.Sprintf("\n\t\tNot null %s: %s", notNull.Name, notNull.Column)
 		}
 	}
 
@@ -0,0 +1,125 @@
+package tableParser
+
+import (
+	"strings"
+)
+
+//attachComments fill the Comment of tables and columns from the comments around them,
+//a comment belongs to the define it ends on the same line with (trailing comment),
+//otherwise to the define right below it without a blank line between (leading comment)
+func attachComments(defs []*TableDefine, scanned []token) {
+	index := map[Pos]int{}
+	for i, t := range scanned {
+		if t.typ != tokenComment {
+			index[t.pos] = i
+		}
+	}
+	for _, def := range defs {
+		if start, found := index[def.pos]; found {
+			end := defineEnd(scanned, start, tokenSemicolon)
+			def.Comment = joinComments(leadingComments(scanned, start), trailingComments(scanned, end))
+		}
+		for _, col := range def.Columns {
+			if start, found := index[col.pos]; found {
+				end := defineEnd(scanned, start, tokenComma)
+				col.Comment = joinComments(leadingComments(scanned, start), trailingComments(scanned, end))
+			}
+		}
+	}
+}
+
+//defineEnd index of the last token of a define which begins at start,
+//the define ends before a stop token or an unmatched right paren
+func defineEnd(scanned []token, start int, stop tokenType) int {
+	depth := 0
+	end := start
+	for i := start; i < len(scanned); i++ {
+		switch scanned[i].typ {
+		case tokenComment:
+			continue
+		case tokenLeftParen:
+			depth++
+		case tokenRightParen:
+			depth--
+		case stop:
+			if depth == 0 {
+				return end
+			}
+		}
+		if depth < 0 {
+			break
+		}
+		end = i
+	}
+	return end
+}
+
+func leadingComments(scanned []token, start int) []token {
+	comments := []token{}
+	nextLine := scanned[start].line
+	for i := start - 1; i >= 0 && scanned[i].typ == tokenComment; i-- {
+		c := scanned[i]
+		if tokenEndLine(c) < nextLine-1 {
+			break
+		}
+		if i > 0 && scanned[i-1].typ != tokenComment && scanned[i-1].line == c.line {
+			// trailing comment of the former token
+			break
+		}
+		comments = append([]token{c}, comments...)
+		nextLine = c.line
+	}
+	return comments
+}
+
+func trailingComments(scanned []token, end int) []token {
+	comments := []token{}
+	line := tokenEndLine(scanned[end])
+	for i := end + 1; i < len(scanned); i++ {
+		t := scanned[i]
+		if t.line != line {
+			break
+		}
+		if t.typ == tokenComment {
+			comments = append(comments, t)
+		} else if t.typ != tokenComma && t.typ != tokenSemicolon {
+			break
+		}
+	}
+	return comments
+}
+
+func tokenEndLine(t token) int {
+	return t.line + strings.Count(t.val, "\n")
+}
+
+func joinComments(groups ...[]token) string {
+	texts := []string{}
+	for _, comments := range groups {
+		for _, c := range comments {
+			if text := commentText(c.val); text != "" {
+				texts = append(texts, text)
+			}
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+//commentText strip the comment markers and the leading * of block comment lines
+func commentText(comment string) string {
+	if strings.HasPrefix(comment, "--") {
+		return strings.TrimSpace(comment[2:])
+	}
+	comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/")
+	lines := []string{}
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "*") && !strings.HasPrefix(line, "*/") {
+			line = strings.TrimSpace(line[1:])
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,479 @@
+package tableParser
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//PrimaryKey a PRIMARY KEY constraint
+type PrimaryKey struct {
+	Name    string
+	Columns []string
+	IndexParameters
+	Deferrability
+}
+
+//Unique a UNIQUE constraint
+type Unique struct {
+	Name             string
+	Columns          []string
+	NullsNotDistinct bool // NULLS NOT DISTINCT, null values are treated as equal
+	IndexParameters
+	Deferrability
+}
+
+//NotNull a NOT NULL constraint of a column
+type NotNull struct {
+	Name   string
+	Column string
+}
+
+//MatchType match type of a foreign key
+type MatchType string
+
+//match types of foreign key
+const (
+	MatchSimple  MatchType = "SIMPLE"
+	MatchFull    MatchType = "FULL"
+	MatchPartial MatchType = "PARTIAL"
+)
+
+//ReferentialAction action of a foreign key when the referenced row is deleted or updated
+type ReferentialAction string
+
+//referential actions of foreign key
+const (
+	ActionNoAction   ReferentialAction = "NO ACTION"
+	ActionRestrict   ReferentialAction = "RESTRICT"
+	ActionCascade    ReferentialAction = "CASCADE"
+	ActionSetNull    ReferentialAction = "SET NULL"
+	ActionSetDefault ReferentialAction = "SET DEFAULT"
+)
+
+//ForeignKey a FOREIGN KEY table constraint or a REFERENCES column constraint
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefSchema  string
+	RefTable   string
+	RefColumns []string // empty if the primary key of the referenced table is used
+	Match      MatchType
+	OnDelete   ReferentialAction
+	OnUpdate   ReferentialAction
+	Deferrability
+}
+
+func newForeignKey(ref tableHeader, refColumns []string) *ForeignKey {
+	return &ForeignKey{
+		RefSchema:  ref.Schema,
+		RefTable:   ref.Table,
+		RefColumns: refColumns,
+		Match:      MatchSimple,
+		OnDelete:   ActionNoAction,
+		OnUpdate:   ActionNoAction,
+	}
+}
+
+//Deferrability when a constraint is checked, only unique, primary key, exclusion
+//and foreign key constraints can be deferred to the end of the transaction
+type Deferrability struct {
+	Deferrable        bool
+	InitiallyDeferred bool
+}
+
+//constraintAttribute a DEFERRABLE or INITIALLY clause and its position
+type constraintAttribute struct {
+	clause string
+	pos    Pos
+}
+
+//constraintAttributes DEFERRABLE and INITIALLY clauses given to a constraint
+type constraintAttributes struct {
+	target     *Deferrability
+	deferrable string // DEFERRABLE or NOT DEFERRABLE, empty if omitted
+	initially  string // INITIALLY DEFERRED or INITIALLY IMMEDIATE, empty if omitted
+}
+
+//add apply a clause to the target, an error is returned for the combinations postgres rejects
+func (a *constraintAttributes) add(attr constraintAttribute) error {
+	switch attr.clause {
+	case "DEFERRABLE", "NOT DEFERRABLE":
+		if a.deferrable != "" {
+			return fmt.Errorf("multiple DEFERRABLE/NOT DEFERRABLE clauses not allowed")
+		}
+		a.deferrable = attr.clause
+	case "INITIALLY DEFERRED", "INITIALLY IMMEDIATE":
+		if a.initially != "" {
+			return fmt.Errorf("multiple INITIALLY IMMEDIATE/DEFERRED clauses not allowed")
+		}
+		a.initially = attr.clause
+	}
+	if a.deferrable == "NOT DEFERRABLE" && a.initially == "INITIALLY DEFERRED" {
+		return fmt.Errorf("constraint declared INITIALLY DEFERRED must be DEFERRABLE")
+	}
+	// INITIALLY DEFERRED implies DEFERRABLE
+	a.target.InitiallyDeferred = a.initially == "INITIALLY DEFERRED"
+	a.target.Deferrable = a.deferrable == "DEFERRABLE" || a.target.InitiallyDeferred
+	return nil
+}
+
+func (fk *ForeignKey) String() string {
+	ref := fk.RefTable
+	if fk.RefSchema != "" {
+		ref = fmt.Sprintf("%s.%s", fk.RefSchema, fk.RefTable)
+	}
+	if len(fk.RefColumns) > 0 {
+		ref += fmt.Sprintf("(%s)", strings.Join(fk.RefColumns, ","))
+	}
+	text := fmt.Sprintf("(%s) REFERENCES %s", strings.Join(fk.Columns, ","), ref)
+	if fk.Match != MatchSimple {
+		text += fmt.Sprintf(" MATCH %s", fk.Match)
+	}
+	if fk.OnDelete != ActionNoAction {
+		text += fmt.Sprintf(" ON DELETE %s", fk.OnDelete)
+	}
+	if fk.OnUpdate != ActionNoAction {
+		text += fmt.Sprintf(" ON UPDATE %s", fk.OnUpdate)
+	}
+	if fk.Deferrable {
+		text += " DEFERRABLE"
+	}
+	if fk.InitiallyDeferred {
+		text += " INITIALLY DEFERRED"
+	}
+	return text
+}
+
+//Check a CHECK constraint
+type Check struct {
+	Name      string
+	Expr      Expr
+	Source    string // source text of the expression, without the enclosing parentheses
+	NoInherit bool
+}
+
+func (c *Check) String() string {
+	if c.NoInherit {
+		return fmt.Sprintf("CHECK (%s) NO INHERIT", c.Expr)
+	}
+	return fmt.Sprintf("CHECK (%s)", c.Expr)
+}
+
+//StorageParameters storage parameters given by WITH (name = value, ...), names are lower case,
+//the value of a parameter given without a value is empty
+type StorageParameters map[string]string
+
+func (p StorageParameters) String() string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		if p[name] != "" {
+			names[i] = fmt.Sprintf("%s=%s", name, p[name])
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+//Int value of an integer parameter, ok is false if the parameter is not given or is not an integer
+func (p StorageParameters) Int(name string) (value int64, ok bool) {
+	text, found := p[name]
+	if !found {
+		return 0, false
+	}
+	value, err := strconv.ParseInt(text, 10, 64)
+	return value, err == nil
+}
+
+//Float value of a floating point parameter like autovacuum_vacuum_scale_factor,
+//ok is false if the parameter is not given or is not a number
+func (p StorageParameters) Float(name string) (value float64, ok bool) {
+	text, found := p[name]
+	if !found {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	return value, err == nil
+}
+
+//Bool value of a boolean parameter, a parameter given without a value is true like postgres does,
+//ok is false if the parameter is not given or is not a boolean
+func (p StorageParameters) Bool(name string) (value bool, ok bool) {
+	text, found := p[name]
+	if !found {
+		return false, false
+	}
+	switch strings.ToLower(text) {
+	case "", "true", "on", "yes", "1", "t", "y":
+		return true, true
+	case "false", "off", "no", "0", "f", "n":
+		return false, true
+	}
+	return false, false
+}
+
+//Fillfactor value of the fillfactor parameter
+func (p StorageParameters) Fillfactor() (int64, bool) {
+	return p.Int("fillfactor")
+}
+
+//AutovacuumEnabled value of the autovacuum_enabled parameter
+func (p StorageParameters) AutovacuumEnabled() (bool, bool) {
+	return p.Bool("autovacuum_enabled")
+}
+
+//IndexParameters parameters of the index which enforces a constraint
+type IndexParameters struct {
+	Include    []string // non-key columns given by INCLUDE
+	With       StorageParameters
+	Tablespace string // tablespace given by USING INDEX TABLESPACE
+}
+
+//Exclusion an EXCLUDE constraint
+type Exclusion struct {
+	Name     string
+	Method   string // index method given by USING, empty if omitted
+	Elements []*ExclusionElem
+	IndexParameters
+	Where       Expr // predicate of a partial exclusion constraint, nil if omitted
+	WhereSource string
+	Deferrability
+}
+
+func (e *Exclusion) String() string {
+	text := "EXCLUDE"
+	if e.Method != "" {
+		text += " USING " + e.Method
+	}
+	elems := make([]string, len(e.Elements))
+	for i, elem := range e.Elements {
+		elems[i] = elem.String()
+	}
+	text += fmt.Sprintf(" (%s)", strings.Join(elems, ", "))
+	if len(e.Include) > 0 {
+		text += fmt.Sprintf(" INCLUDE (%s)", strings.Join(e.Include, ", "))
+	}
+	if len(e.With) > 0 {
+		text += fmt.Sprintf(" WITH (%s)", e.With)
+	}
+	if e.Tablespace != "" {
+		text += " USING INDEX TABLESPACE " + e.Tablespace
+	}
+	if e.Where != nil {
+		text += fmt.Sprintf(" WHERE (%s)", e.Where)
+	}
+	return text
+}
+
+//ExclusionElem an element of an EXCLUDE constraint like room_id WITH =
+type ExclusionElem struct {
+	Column     string // column of the element, empty if the element is an expression
+	Expr       Expr
+	Source     string // source text of Expr
+	OpClass    string
+	Order      string // ASC, DESC or empty
+	NullsOrder string // FIRST, LAST or empty
+	Operator   string
+}
+
+func (e *ExclusionElem) String() string {
+	text := e.Column
+	if e.Expr != nil {
+		if _, ok := e.Expr.(*FuncCall); ok {
+			text = e.Expr.String()
+		} else {
+			text = fmt.Sprintf("(%s)", e.Expr)
+		}
+	}
+	for _, part := range []string{e.OpClass, e.Order} {
+		if part != "" {
+			text += " " + part
+		}
+	}
+	if e.NullsOrder != "" {
+		text += " NULLS " + e.NullsOrder
+	}
+	return fmt.Sprintf("%s WITH %s", text, e.Operator)
+}
+
+//name name of the element used in a generated constraint name
+func (e *ExclusionElem) name() string {
+	if e.Column != "" {
+		return e.Column
+	}
+	if call, ok := e.Expr.(*FuncCall); ok {
+		return call.Name
+	}
+	return "expr"
+}
+
+//setName name the constraint of a table constraint element
+func (c *TableConstraint) setName(name string) {
+	switch {
+	case c.PrimaryKey != nil:
+		c.PrimaryKey.Name = name
+	case len(c.Uniques) > 0:
+		c.Uniques[0].Name = name
+	case len(c.ForeignKeys) > 0:
+		c.ForeignKeys[0].Name = name
+	case len(c.Checks) > 0:
+		c.Checks[0].Name = name
+	case len(c.Exclusions) > 0:
+		c.Exclusions[0].Name = name
+	}
+}
+
+//setAttributes apply the DEFERRABLE and INITIALLY clauses to the constraint of a table constraint element
+func (c *TableConstraint) setAttributes(attrs []constraintAttribute) (Pos, error) {
+	attributes := constraintAttributes{target: &Deferrability{}}
+	switch {
+	case c.PrimaryKey != nil:
+		attributes.target = &c.PrimaryKey.Deferrability
+	case len(c.Uniques) > 0:
+		attributes.target = &c.Uniques[0].Deferrability
+	case len(c.ForeignKeys) > 0:
+		attributes.target = &c.ForeignKeys[0].Deferrability
+	case len(c.Exclusions) > 0:
+		attributes.target = &c.Exclusions[0].Deferrability
+	}
+	for _, attr := range attrs {
+		if err := attributes.add(attr); err != nil {
+			return attr.pos, err
+		}
+		if len(c.Checks) > 0 && attributes.target.Deferrable {
+			return attr.pos, fmt.Errorf("CHECK constraints cannot be marked DEFERRABLE")
+		}
+	}
+	return 0, nil
+}
+
+//nameConstraints give every constraint without an explicit name the name postgres would generate for it
+func (c *TableConstraint) nameConstraints(table string) {
+	used := map[string]bool{}
+	for _, name := range c.names() {
+		used[name] = true
+	}
+	if c.PrimaryKey != nil && c.PrimaryKey.Name == "" {
+		c.PrimaryKey.Name = chooseConstraintName(table, "", "pkey", used)
+	}
+	for _, unique := range c.Uniques {
+		if unique.Name == "" {
+			unique.Name = chooseConstraintName(table, strings.Join(unique.Columns, "_"), "key", used)
+		}
+	}
+	for _, fk := range c.ForeignKeys {
+		if fk.Name == "" {
+			fk.Name = chooseConstraintName(table, strings.Join(fk.Columns, "_"), "fkey", used)
+		}
+	}
+	for _, check := range c.Checks {
+		if check.Name == "" {
+			// postgres puts the column into the name if the expression refers to only one column
+			column := ""
+			if refs := columnRefs(check.Expr); len(refs) == 1 {
+				column = refs[0]
+			}
+			check.Name = chooseConstraintName(table, column, "check", used)
+		}
+	}
+	for _, exclusion := range c.Exclusions {
+		if exclusion.Name == "" {
+			columns := []string{}
+			for _, elem := range exclusion.Elements {
+				columns = append(columns, elem.name())
+			}
+			exclusion.Name = chooseConstraintName(table, strings.Join(columns, "_"), "excl", used)
+		}
+	}
+	for _, notNull := range c.NotNulls {
+		if notNull.Name == "" {
+			notNull.Name = chooseConstraintName(table, notNull.Column, "not_null", used)
+		}
+	}
+}
+
+//names explicit names of the constraints
+func (c *TableConstraint) names() []string {
+	names := []string{}
+	if c.PrimaryKey != nil {
+		names = append(names, c.PrimaryKey.Name)
+	}
+	for _, unique := range c.Uniques {
+		names = append(names, unique.Name)
+	}
+	for _, fk := range c.ForeignKeys {
+		names = append(names, fk.Name)
+	}
+	for _, check := range c.Checks {
+		names = append(names, check.Name)
+	}
+	for _, exclusion := range c.Exclusions {
+		names = append(names, exclusion.Name)
+	}
+	for _, notNull := range c.NotNulls {
+		names = append(names, notNull.Name)
+	}
+	return names
+}
+
+//maxIdentifierLength max bytes of an identifier in postgres
+const maxIdentifierLength = 63
+
+//chooseConstraintName choose a name not used yet, add a number to the label like postgres on conflict
+func chooseConstraintName(name1, name2, label string, used map[string]bool) string {
+	for pass := 0; ; pass++ {
+		modLabel := label
+		if pass > 0 {
+			modLabel = fmt.Sprintf("%s%d", label, pass)
+		}
+		name := makeObjectName(name1, name2, modLabel)
+		if !used[name] {
+			used[name] = true
+			return name
+		}
+	}
+}
+
+//makeObjectName join name1, name2 and label by "_", the longer one of name1 and name2
+//is truncated first to keep the name within maxIdentifierLength
+func makeObjectName(name1, name2, label string) string {
+	overhead := 0
+	if label != "" {
+		overhead += len(label) + 1
+	}
+	if name2 != "" {
+		overhead++
+	}
+	name1Chars, name2Chars := len(name1), len(name2)
+	for name1Chars+name2Chars > maxIdentifierLength-overhead {
+		if name1Chars > name2Chars {
+			name1Chars--
+		} else {
+			name2Chars--
+		}
+	}
+	name := clipName(name1, name1Chars)
+	if name2 != "" {
+		name += "_" + clipName(name2, name2Chars)
+	}
+	if label != "" {
+		name += "_" + label
+	}
+	return name
+}
+
+//clipName cut a name to at most n bytes without breaking a multibyte character
+func clipName(name string, n int) string {
+	if n >= len(name) {
+		return name
+	}
+	for n > 0 && !utf8.RuneStart(name[n]) {
+		n--
+	}
+	return name[:n]
+}
@@ -0,0 +1,264 @@
+package tableParser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//DataType structured define of a column data type
+type DataType struct {
+	Schema         string
+	Name           string   // type name as written, multi-word types like "DOUBLE PRECISION" are joined by a space
+	Modifiers      []string // type modifiers in parentheses, e.g. 10 and 2 in NUMERIC(10,2)
+	IntervalFields string   // fields restriction of an interval type, e.g. "DAY TO SECOND"
+	ArrayDims      []int    // size of each array dimension, -1 if the size is not specified
+
+	quoted bool // Name is a quoted identifier
+}
+
+//String full type text as written, include schema and modifiers
+func (t *DataType) String() string {
+	name := t.Name
+	if t.Schema != "" {
+		name = fmt.Sprintf("%s.%s", t.Schema, t.Name)
+	}
+	return formatType(name, t.IntervalFields, t.Modifiers, t.ArrayDims, false)
+}
+
+//CanonicalName canonical name of the type without modifiers, e.g. "timestamp with time zone" for TIMESTAMPTZ
+func (t *DataType) CanonicalName() string {
+	if t.isInternalChar() {
+		return `"char"`
+	}
+	name := strings.ToLower(strings.Join(strings.Fields(t.Name), " "))
+	if t.Schema != "" && strings.ToLower(t.Schema) != "pg_catalog" {
+		return fmt.Sprintf("%s.%s", t.Schema, t.Name)
+	}
+	if name == "float" {
+		if precision, ok := t.modifier(0); ok && precision <= 24 {
+			return "real"
+		}
+		return "double precision"
+	}
+	if canonical, found := canonicalTypes[name]; found {
+		return canonical
+	}
+	if t.Schema != "" {
+		return fmt.Sprintf("%s.%s", t.Schema, t.Name)
+	}
+	return t.Name
+}
+
+//Canonical full canonical type text, include modifiers and array dimensions,
+//two types with the same canonical text are the same type in postgres
+func (t *DataType) Canonical() string {
+	modifiers := t.Modifiers
+	if strings.ToLower(t.Name) == "float" {
+		modifiers = nil
+	}
+	if length, ok := t.defaultLength(); ok && len(modifiers) == 0 {
+		modifiers = []string{strconv.Itoa(length)}
+	}
+	return formatType(t.CanonicalName(), strings.ToLower(t.IntervalFields), modifiers, t.ArrayDims, true)
+}
+
+//isInternalChar whether the type is the one byte "char" type of postgres, which is named char
+//only when quoted or qualified by pg_catalog, an unquoted char is character(1)
+func (t *DataType) isInternalChar() bool {
+	name := t.Name
+	if !t.quoted {
+		name = strings.ToLower(name)
+	}
+	schema := strings.ToLower(t.Schema)
+	return name == "char" && ((t.quoted && schema == "") || schema == "pg_catalog")
+}
+
+//defaultLength length of a character or bit type declared without one, which is 1 in postgres,
+//bpchar without a length has no limit
+func (t *DataType) defaultLength() (int, bool) {
+	switch t.CanonicalName() {
+	case "character":
+		if strings.ToLower(t.Name) == "bpchar" {
+			return 0, false
+		}
+		return 1, true
+	case "bit":
+		return 1, true
+	}
+	return 0, false
+}
+
+//formatType put modifiers into a type name, time types place them before the time zone words
+func formatType(name, fields string, modifiers []string, arrayDims []int, canonical bool) string {
+	if fields != "" {
+		name += " " + fields
+	}
+	if len(modifiers) > 0 {
+		mods := fmt.Sprintf("(%s)", strings.Join(modifiers, ","))
+		words := strings.SplitN(name, " ", 2)
+		switch strings.ToLower(words[0]) {
+		case "time", "timestamp":
+			if len(words) == 2 {
+				name = words[0] + mods + " " + words[1]
+				break
+			}
+			fallthrough
+		default:
+			name += mods
+		}
+	}
+	for _, dim := range arrayDims {
+		if dim < 0 || canonical {
+			name += "[]"
+		} else {
+			name += fmt.Sprintf("[%d]", dim)
+		}
+	}
+	return name
+}
+
+//IsArray whether the type is an array type
+func (t *DataType) IsArray() bool {
+	return len(t.ArrayDims) > 0
+}
+
+//ElemType element type of an array type, return a copy of the type if it is not an array
+func (t *DataType) ElemType() *DataType {
+	elem := *t
+	elem.ArrayDims = nil
+	return &elem
+}
+
+//arrayBound array dimension size from a number token, postgres does not enforce it anyway
+func arrayBound(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+var canonicalTypes = map[string]string{
+	"int":                         "integer",
+	"int4":                        "integer",
+	"integer":                     "integer",
+	"int2":                        "smallint",
+	"smallint":                    "smallint",
+	"int8":                        "bigint",
+	"bigint":                      "bigint",
+	"float4":                      "real",
+	"real":                        "real",
+	"float8":                      "double precision",
+	"double precision":            "double precision",
+	"numeric":                     "numeric",
+	"decimal":                     "numeric",
+	"dec":                         "numeric",
+	"bool":                        "boolean",
+	"boolean":                     "boolean",
+	"varchar":                     "character varying",
+	"character varying":           "character varying",
+	"char varying":                "character varying",
+	"nchar varying":               "character varying",
+	"national character varying":  "character varying",
+	"national char varying":       "character varying",
+	"char":                        "character",
+	"character":                   "character",
+	"bpchar":                      "character",
+	"nchar":                       "character",
+	"national character":          "character",
+	"national char":               "character",
+	"bit":                         "bit",
+	"varbit":                      "bit varying",
+	"bit varying":                 "bit varying",
+	"timestamp":                   "timestamp without time zone",
+	"timestamp without time zone": "timestamp without time zone",
+	"timestamptz":                 "timestamp with time zone",
+	"timestamp with time zone":    "timestamp with time zone",
+	"time":                        "time without time zone",
+	"time without time zone":      "time without time zone",
+	"timetz":                      "time with time zone",
+	"time with time zone":         "time with time zone",
+	"interval":                    "interval",
+	"date":                        "date",
+	"serial":                      "serial",
+	"serial4":                     "serial",
+	"bigserial":                   "bigserial",
+	"serial8":                     "bigserial",
+	"smallserial":                 "smallserial",
+	"serial2":                     "smallserial",
+	"text":                        "text",
+	"bytea":                       "bytea",
+	"json":                        "json",
+	"jsonb":                       "jsonb",
+	"uuid":                        "uuid",
+	"money":                       "money",
+	"xml":                         "xml",
+	"inet":                        "inet",
+	"cidr":                        "cidr",
+	"macaddr":                     "macaddr",
+	"tsvector":                    "tsvector",
+	"tsquery":                     "tsquery",
+	"oid":                         "oid",
+	"regclass":                    "regclass",
+}
+
+var lengthTypes = map[string]bool{
+	"character":         true,
+	"character varying": true,
+	"bit":               true,
+	"bit varying":       true,
+}
+
+var precisionTypes = map[string]bool{
+	"numeric":                     true,
+	"time without time zone":      true,
+	"time with time zone":         true,
+	"timestamp without time zone": true,
+	"timestamp with time zone":    true,
+	"interval":                    true,
+}
+
+func (t *DataType) modifier(index int) (int, bool) {
+	if index >= len(t.Modifiers) {
+		return 0, false
+	}
+	v, err := strconv.Atoi(t.Modifiers[index])
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+//Length max length of a character or bit string type, e.g. 255 in VARCHAR(255),
+//CHAR and BIT without a length are 1
+func (t *DataType) Length() (int, bool) {
+	if !lengthTypes[t.CanonicalName()] {
+		return 0, false
+	}
+	if length, ok := t.defaultLength(); ok && len(t.Modifiers) == 0 {
+		return length, true
+	}
+	return t.modifier(0)
+}
+
+//Precision precision of a numeric, float or datetime type, e.g. 10 in NUMERIC(10,2) or 3 in TIME(3)
+func (t *DataType) Precision() (int, bool) {
+	if !precisionTypes[t.CanonicalName()] && strings.ToLower(t.Name) != "float" {
+		return 0, false
+	}
+	return t.modifier(0)
+}
+
+//Scale scale of a numeric type, e.g. 2 in NUMERIC(10,2), an omitted scale is 0
+func (t *DataType) Scale() (int, bool) {
+	if t.CanonicalName() != "numeric" {
+		return 0, false
+	}
+	if len(t.Modifiers) == 1 {
+		if _, ok := t.modifier(0); ok {
+			return 0, true
+		}
+	}
+	return t.modifier(1)
+}
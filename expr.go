@@ -0,0 +1,381 @@
+package tableParser
+
+import (
+	"fmt"
+	"strings"
+)
+
+//Expr an expression node, such as the DEFAULT value of a column
+type Expr interface {
+	Pos() Pos       // position of the first byte of the expression in the input
+	End() Pos       // position of the byte right after the expression in the input
+	String() string // expression text rebuilt from the node
+}
+
+type span struct {
+	pos Pos
+	end Pos
+}
+
+func (s span) Pos() Pos { return s.pos }
+func (s span) End() Pos { return s.end }
+
+//LiteralKind kind of a constant value
+type LiteralKind int
+
+//kinds of literal
+const (
+	LiteralString LiteralKind = iota
+	LiteralNumber
+	LiteralBool
+	LiteralNull
+)
+
+//Literal a constant value, Value of a string literal is unquoted
+type Literal struct {
+	span
+	Kind  LiteralKind
+	Value string
+}
+
+func (e *Literal) String() string {
+	switch e.Kind {
+	case LiteralString:
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(e.Value, "'", "''"))
+	case LiteralBool, LiteralNull:
+		return strings.ToUpper(e.Value)
+	}
+	return e.Value
+}
+
+//ColumnRef a reference to a column or another named object, Names are the dot separated parts
+type ColumnRef struct {
+	span
+	Names []string
+}
+
+func (e *ColumnRef) String() string {
+	return strings.Join(e.Names, ".")
+}
+
+//SQLValueFunc a SQL value keyword like CURRENT_TIMESTAMP or CURRENT_USER, Name is upper case
+type SQLValueFunc struct {
+	span
+	Name string
+}
+
+func (e *SQLValueFunc) String() string {
+	return e.Name
+}
+
+//sqlValueFuncs the keywords postgres reads as SQLValueFunc when they are not quoted
+var sqlValueFuncs = map[string]bool{
+	"CURRENT_DATE":      true,
+	"CURRENT_TIME":      true,
+	"CURRENT_TIMESTAMP": true,
+	"LOCALTIME":         true,
+	"LOCALTIMESTAMP":    true,
+	"CURRENT_ROLE":      true,
+	"CURRENT_USER":      true,
+	"SESSION_USER":      true,
+	"USER":              true,
+	"CURRENT_CATALOG":   true,
+	"CURRENT_SCHEMA":    true,
+}
+
+//FuncCall a function call like now() or public.uuid_generate_v4()
+type FuncCall struct {
+	span
+	Schema string
+	Name   string
+	Args   []Expr
+}
+
+func (e *FuncCall) String() string {
+	name := e.Name
+	if e.Schema != "" {
+		name = fmt.Sprintf("%s.%s", e.Schema, e.Name)
+	}
+	return fmt.Sprintf("%s(%s)", name, joinExprs(e.Args, ", "))
+}
+
+//TypeCast a type cast like '{}'::jsonb
+type TypeCast struct {
+	span
+	Arg  Expr
+	Type *DataType
+}
+
+func (e *TypeCast) String() string {
+	switch e.Arg.(type) {
+	case *UnaryExpr, *BinaryExpr, *IsExpr, *InExpr, *BetweenExpr:
+		return fmt.Sprintf("(%s)::%s", e.Arg, e.Type)
+	}
+	return fmt.Sprintf("%s::%s", e.Arg, e.Type)
+}
+
+//ParenExpr a parenthesized expression
+type ParenExpr struct {
+	span
+	X Expr
+}
+
+func (e *ParenExpr) String() string {
+	return fmt.Sprintf("(%s)", e.X)
+}
+
+//UnaryExpr a prefix operator expression like -a or NOT a
+type UnaryExpr struct {
+	span
+	Op  string
+	Arg Expr
+}
+
+func (e *UnaryExpr) String() string {
+	if e.Op == "NOT" {
+		return fmt.Sprintf("NOT %s", e.Arg)
+	}
+	arg := e.Arg.String()
+	if arg != "" && isOperatorChar(rune(arg[0])) {
+		// keep the operators apart, -- begins a comment and @- would be read as one operator
+		return e.Op + " " + arg
+	}
+	return e.Op + arg
+}
+
+//BinaryExpr an infix operator expression, Op is the operator as written or
+//an upper case keyword operator like AND, OR, LIKE, NOT LIKE and IS DISTINCT FROM
+type BinaryExpr struct {
+	span
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+func (e *BinaryExpr) String() string {
+	return fmt.Sprintf("%s %s %s", e.Left, e.Op, e.Right)
+}
+
+//IsTest what an IS expression tests
+type IsTest int
+
+//tests of IS expression
+const (
+	IsNull IsTest = iota
+	IsTrue
+	IsFalse
+	IsUnknown
+)
+
+var isTestNames = map[IsTest]string{
+	IsNull:    "NULL",
+	IsTrue:    "TRUE",
+	IsFalse:   "FALSE",
+	IsUnknown: "UNKNOWN",
+}
+
+//IsExpr a test like a IS NULL or a IS NOT TRUE, ISNULL and NOTNULL are also IsExpr
+type IsExpr struct {
+	span
+	Arg  Expr
+	Not  bool
+	Test IsTest
+}
+
+func (e *IsExpr) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s IS NOT %s", e.Arg, isTestNames[e.Test])
+	}
+	return fmt.Sprintf("%s IS %s", e.Arg, isTestNames[e.Test])
+}
+
+//InExpr a list membership test like a IN (1, 2)
+type InExpr struct {
+	span
+	Arg  Expr
+	Not  bool
+	List []Expr
+}
+
+func (e *InExpr) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s NOT IN (%s)", e.Arg, joinExprs(e.List, ", "))
+	}
+	return fmt.Sprintf("%s IN (%s)", e.Arg, joinExprs(e.List, ", "))
+}
+
+//BetweenExpr a range test like a BETWEEN 1 AND 10
+type BetweenExpr struct {
+	span
+	Arg  Expr
+	Not  bool
+	Low  Expr
+	High Expr
+}
+
+func (e *BetweenExpr) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s NOT BETWEEN %s AND %s", e.Arg, e.Low, e.High)
+	}
+	return fmt.Sprintf("%s BETWEEN %s AND %s", e.Arg, e.Low, e.High)
+}
+
+//CaseWhen one WHEN ... THEN ... branch of a CASE expression
+type CaseWhen struct {
+	Cond   Expr
+	Result Expr
+}
+
+//CaseExpr a CASE expression, Arg is nil for a searched CASE
+type CaseExpr struct {
+	span
+	Arg   Expr
+	Whens []*CaseWhen
+	Else  Expr
+}
+
+func (e *CaseExpr) String() string {
+	text := "CASE"
+	if e.Arg != nil {
+		text += " " + e.Arg.String()
+	}
+	for _, when := range e.Whens {
+		text += fmt.Sprintf(" WHEN %s THEN %s", when.Cond, when.Result)
+	}
+	if e.Else != nil {
+		text += " ELSE " + e.Else.String()
+	}
+	return text + " END"
+}
+
+//ArrayExpr an array constructor like ARRAY[1, 2], Nested is true for the inner
+//dimensions of a multi-dimensional constructor which are written without ARRAY
+type ArrayExpr struct {
+	span
+	Elems  []Expr
+	Nested bool
+}
+
+func (e *ArrayExpr) String() string {
+	if e.Nested {
+		return fmt.Sprintf("[%s]", joinExprs(e.Elems, ", "))
+	}
+	return fmt.Sprintf("ARRAY[%s]", joinExprs(e.Elems, ", "))
+}
+
+//RowExpr a row constructor like ROW(1, 'a'), Implicit is true if it is written as (1, 'a')
+type RowExpr struct {
+	span
+	Args     []Expr
+	Implicit bool
+}
+
+func (e *RowExpr) String() string {
+	if e.Implicit {
+		return fmt.Sprintf("(%s)", joinExprs(e.Args, ", "))
+	}
+	return fmt.Sprintf("ROW(%s)", joinExprs(e.Args, ", "))
+}
+
+//NextvalSequence name of the sequence if the expression is a nextval('seq'::regclass) call
+func NextvalSequence(e Expr) (string, bool) {
+	call, ok := e.(*FuncCall)
+	if !ok || strings.ToLower(call.Name) != "nextval" || len(call.Args) != 1 {
+		return "", false
+	}
+	if call.Schema != "" && strings.ToLower(call.Schema) != "pg_catalog" {
+		return "", false
+	}
+	arg := call.Args[0]
+	if cast, ok := arg.(*TypeCast); ok && cast.Type.CanonicalName() == "regclass" {
+		arg = cast.Arg
+	}
+	if lit, ok := arg.(*Literal); ok && lit.Kind == LiteralString {
+		return lit.Value, true
+	}
+	return "", false
+}
+
+//columnRefs distinct names of the columns referred by the expression
+func columnRefs(e Expr) []string {
+	names := []string{}
+	found := map[string]bool{}
+	walkExpr(e, func(e Expr) {
+		if ref, ok := e.(*ColumnRef); ok {
+			name := ref.Names[len(ref.Names)-1]
+			if !found[name] {
+				found[name] = true
+				names = append(names, name)
+			}
+		}
+	})
+	return names
+}
+
+//walkExpr call fn on the expression and then on all of its sub expressions
+func walkExpr(e Expr, fn func(Expr)) {
+	if e == nil {
+		return
+	}
+	fn(e)
+	switch e := e.(type) {
+	case *FuncCall:
+		walkExprs(e.Args, fn)
+	case *TypeCast:
+		walkExpr(e.Arg, fn)
+	case *ParenExpr:
+		walkExpr(e.X, fn)
+	case *UnaryExpr:
+		walkExpr(e.Arg, fn)
+	case *BinaryExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *IsExpr:
+		walkExpr(e.Arg, fn)
+	case *InExpr:
+		walkExpr(e.Arg, fn)
+		walkExprs(e.List, fn)
+	case *BetweenExpr:
+		walkExpr(e.Arg, fn)
+		walkExpr(e.Low, fn)
+		walkExpr(e.High, fn)
+	case *CaseExpr:
+		walkExpr(e.Arg, fn)
+		for _, when := range e.Whens {
+			walkExpr(when.Cond, fn)
+			walkExpr(when.Result, fn)
+		}
+		walkExpr(e.Else, fn)
+	case *ArrayExpr:
+		walkExprs(e.Elems, fn)
+	case *RowExpr:
+		walkExprs(e.Args, fn)
+	}
+}
+
+func walkExprs(exprs []Expr, fn func(Expr)) {
+	for _, e := range exprs {
+		walkExpr(e, fn)
+	}
+}
+
+func newUnaryExpr(pos Pos, op string, arg Expr) Expr {
+	op = strings.ToUpper(op)
+	if lit, ok := arg.(*Literal); ok && op == "-" && lit.Kind == LiteralNumber && !strings.HasPrefix(lit.Value, "-") {
+		// fold the minus into the number like postgres does
+		return &Literal{span: span{pos, lit.End()}, Kind: LiteralNumber, Value: "-" + lit.Value}
+	}
+	return &UnaryExpr{span: span{pos, arg.End()}, Op: op, Arg: arg}
+}
+
+func newBinaryExpr(op string, left, right Expr) Expr {
+	return &BinaryExpr{span: span{left.Pos(), right.End()}, Op: strings.ToUpper(op), Left: left, Right: right}
+}
+
+func joinExprs(exprs []Expr, sep string) string {
+	texts := make([]string, len(exprs))
+	for i, e := range exprs {
+		texts[i] = e.String()
+	}
+	return strings.Join(texts, sep)
+}
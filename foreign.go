@@ -0,0 +1,51 @@
+package tableParser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//GenericOptions options of a foreign table or its columns given by OPTIONS (name 'value', ...),
+//they are passed to the foreign data wrapper, names are lower case unless quoted
+type GenericOptions map[string]string
+
+func (o GenericOptions) String() string {
+	names := make([]string, 0, len(o))
+	for name := range o {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s '%s'", name, strings.ReplaceAll(o[name], "'", "''"))
+	}
+	return strings.Join(names, ", ")
+}
+
+//addGenericOption add an option given at pos, an option can only be given once
+func (l *lexer) addGenericOption(options GenericOptions, pos Pos, name, value string) {
+	if _, found := options[name]; found {
+		l.errorAt(pos, "option %q provided more than once", name)
+		return
+	}
+	options[name] = value
+}
+
+//setForeign flag the table as a foreign table of the server,
+//the constraints which need an index are not supported on foreign tables
+func (l *lexer) setForeign(def *TableDefine, server string, options GenericOptions) {
+	def.Foreign = true
+	def.Server = server
+	def.Options = options
+	c := def.Constraint
+	switch {
+	case c.PrimaryKey != nil:
+		l.errorAt(def.pos, "primary key constraints are not supported on foreign tables")
+	case len(c.Uniques) > 0:
+		l.errorAt(def.pos, "unique constraints are not supported on foreign tables")
+	case len(c.Exclusions) > 0:
+		l.errorAt(def.pos, "exclusion constraints are not supported on foreign tables")
+	case len(c.ForeignKeys) > 0:
+		l.errorAt(def.pos, "foreign key constraints are not supported on foreign tables")
+	}
+}
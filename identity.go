@@ -0,0 +1,33 @@
+package tableParser
+
+import (
+	"strconv"
+)
+
+//Identity an identity column defined by GENERATED ... AS IDENTITY
+type Identity struct {
+	Always   bool // GENERATED ALWAYS, otherwise GENERATED BY DEFAULT
+	Sequence SequenceOptions
+}
+
+//SequenceOptions options of the sequence behind an identity column, nil values are omitted
+type SequenceOptions struct {
+	Name      string    // SEQUENCE NAME
+	Type      *DataType // AS data_type
+	Start     *int64
+	Increment *int64
+	MinValue  *int64 // nil for NO MINVALUE
+	MaxValue  *int64 // nil for NO MAXVALUE
+	Cache     *int64
+	Cycle     bool
+}
+
+//sequenceValue numeric value of a sequence option, report an error if it is not an integer
+func (l *lexer) sequenceValue(pos Pos, s string) *int64 {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		l.errorAt(pos, "sequence option value %s is not a bigint", s)
+		return nil
+	}
+	return &v
+}
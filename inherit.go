@@ -0,0 +1,141 @@
+package tableParser
+
+import (
+	"fmt"
+)
+
+//TableName a table name which may be qualified by a schema
+type TableName struct {
+	Schema string
+	Table  string
+}
+
+func (n TableName) String() string {
+	if n.Schema == "" {
+		return n.Table
+	}
+	return fmt.Sprintf("%s.%s", n.Schema, n.Table)
+}
+
+//findTable the table define with the name as postgres looks it up, nil if it is not found
+func findTable(defs []*TableDefine, key TableName) *TableDefine {
+	for _, def := range defs {
+		if def.key == key {
+			return def
+		}
+	}
+	return nil
+}
+
+//setInherits set the parents given by INHERITS
+func (d *TableDefine) setInherits(parents []tableHeader) {
+	for _, parent := range parents {
+		d.Inherits = append(d.Inherits, TableName{parent.Schema, parent.Table})
+		d.inheritKeys = append(d.inheritKeys, parent.key)
+	}
+}
+
+//linkParents link partitions and inheriting tables to their parents defined in the same input
+func linkParents(defs []*TableDefine) {
+	for _, def := range defs {
+		if def.PartitionOf != nil {
+			if parent := findTable(defs, def.PartitionOf.parentKey); parent != nil {
+				def.Parent = parent
+				parent.Partitions = append(parent.Partitions, def)
+			}
+		}
+		def.inheritParents = make([]*TableDefine, len(def.Inherits))
+		for i, key := range def.inheritKeys {
+			def.inheritParents[i] = findTable(defs, key)
+		}
+	}
+}
+
+//EffectiveColumns columns of the table include the ones inherited from the INHERITS parents
+//or the parent of a partition, the parents must be defined in the same input.
+//Columns with the same name are merged like postgres does: the types must be the same,
+//the column is not nullable if any of them is not, and a local DEFAULT overrides the inherited ones
+func (d *TableDefine) EffectiveColumns() ([]*TableColumn, error) {
+	return d.effectiveColumns(map[*TableDefine]bool{})
+}
+
+func (d *TableDefine) effectiveColumns(visiting map[*TableDefine]bool) ([]*TableColumn, error) {
+	if visiting[d] {
+		return nil, fmt.Errorf("circular inheritance of table %q", d.Table)
+	}
+	visiting[d] = true
+	defer delete(visiting, d)
+
+	parents := d.inheritParents
+	names := d.Inherits
+	if d.PartitionOf != nil {
+		parents = []*TableDefine{d.Parent}
+		names = []TableName{{d.PartitionOf.ParentSchema, d.PartitionOf.Parent}}
+	}
+	columns := []*TableColumn{}
+	index := map[string]int{}
+	conflictDefaults := map[string]bool{}
+	for i, parent := range parents {
+		if parent == nil {
+			return nil, fmt.Errorf("parent table %q of table %q is not in the input", names[i], d.Table)
+		}
+		parentColumns, err := parent.effectiveColumns(visiting)
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range parentColumns {
+			at, found := index[column.Name]
+			if !found {
+				inherited := *column
+				index[column.Name] = len(columns)
+				columns = append(columns, &inherited)
+				continue
+			}
+			merged := columns[at]
+			if merged.CanonicalType != column.CanonicalType {
+				return nil, fmt.Errorf("inherited column %q has a type conflict: %s versus %s", column.Name, merged.Type, column.Type)
+			}
+			merged.Nullable = merged.Nullable && column.Nullable
+			switch {
+			case merged.Default == "":
+				merged.Default, merged.DefaultExpr = column.Default, column.DefaultExpr
+			case column.Default != "" && merged.Default != column.Default:
+				conflictDefaults[column.Name] = true
+			}
+		}
+	}
+	for _, column := range d.Columns {
+		at, found := index[column.Name]
+		if !found && column.DataType == nil && len(parents) > 0 {
+			// the column of a partition only adds options to a column of the parent
+			return nil, fmt.Errorf("column %q of table %q does not exist", column.Name, d.Table)
+		}
+		if !found {
+			local := *column
+			index[column.Name] = len(columns)
+			columns = append(columns, &local)
+			continue
+		}
+		merged := columns[at]
+		if column.DataType != nil && merged.CanonicalType != column.CanonicalType {
+			return nil, fmt.Errorf("column %q has a type conflict: %s versus %s", column.Name, merged.Type, column.Type)
+		}
+		merged.Nullable = merged.Nullable && column.Nullable
+		if column.DefaultExpr != nil {
+			merged.Default, merged.DefaultExpr = column.Default, column.DefaultExpr
+			delete(conflictDefaults, column.Name)
+		}
+		if column.Collation != "" {
+			merged.Collation, merged.CollationSchema = column.Collation, column.CollationSchema
+		}
+		if column.Comment != "" {
+			merged.Comment = column.Comment
+		}
+	}
+	for _, column := range columns {
+		if conflictDefaults[column.Name] {
+			return nil, fmt.Errorf("column %q inherits conflicting default values", column.Name)
+		}
+	}
+	return columns, nil
+}
@@ -16,6 +16,7 @@ type token struct {
 	pos  Pos       // the starting position, in bytes, of this token in the input string
 	val  string    // the value of this token
 	line int       // the line number at the start of this token
+	end  Pos       // the position right after this token in the input string
 }
 
 func (i token) String() string {
@@ -39,6 +40,8 @@ var runeType = map[rune]tokenType{
 	',': tokenComma,
 	'(': tokenLeftParen,
 	')': tokenRightParen,
+	'[': tokenLeftBracket,
+	']': tokenRightBracket,
 	';': tokenSemicolon,
 }
 
@@ -53,6 +56,156 @@ var keywords = map[string]tokenType{
 	"unique":  tokenUNIQUE,
 	"primary": tokenPRIMARY,
 	"key":     tokenKEY,
+	"array":   tokenARRAY,
+	"true":    tokenTRUE,
+	"false":   tokenFALSE,
+
+	"double":    tokenDOUBLE,
+	"precision": tokenPRECISION,
+	"character": tokenCHARACTER,
+	"char":      tokenCHAR,
+	"varying":   tokenVARYING,
+	"national":  tokenNATIONAL,
+	"nchar":     tokenNCHAR,
+	"bit":       tokenBIT,
+	"timestamp": tokenTIMESTAMP,
+	"time":      tokenTIME,
+	"with":      tokenWITH,
+	"without":   tokenWITHOUT,
+	"zone":      tokenZONE,
+	"interval":  tokenINTERVAL,
+	"year":      tokenYEAR,
+	"month":     tokenMONTH,
+	"day":       tokenDAY,
+	"hour":      tokenHOUR,
+	"minute":    tokenMINUTE,
+	"second":    tokenSECOND,
+	"to":        tokenTO,
+
+	"and":      tokenAND,
+	"or":       tokenOR,
+	"is":       tokenIS,
+	"isnull":   tokenISNULL,
+	"notnull":  tokenNOTNULL,
+	"unknown":  tokenUNKNOWN,
+	"distinct": tokenDISTINCT,
+	"from":     tokenFROM,
+	"between":  tokenBETWEEN,
+	"in":       tokenIN,
+	"like":     tokenLIKE,
+	"ilike":    tokenILIKE,
+	"case":     tokenCASE,
+	"when":     tokenWHEN,
+	"then":     tokenTHEN,
+	"else":     tokenELSE,
+	"end":      tokenEND,
+	"cast":     tokenCAST,
+	"as":       tokenAS,
+	"row":      tokenROW,
+
+	"foreign":    tokenFOREIGN,
+	"references": tokenREFERENCES,
+	"match":      tokenMATCH,
+	"full":       tokenFULL,
+	"partial":    tokenPARTIAL,
+	"simple":     tokenSIMPLE,
+	"on":         tokenON,
+	"delete":     tokenDELETE,
+	"update":     tokenUPDATE,
+	"cascade":    tokenCASCADE,
+	"restrict":   tokenRESTRICT,
+	"set":        tokenSET,
+	"no":         tokenNO,
+	"action":     tokenACTION,
+	"deferrable": tokenDEFERRABLE,
+	"initially":  tokenINITIALLY,
+	"deferred":   tokenDEFERRED,
+	"immediate":  tokenIMMEDIATE,
+	"check":      tokenCHECK,
+	"inherit":    tokenINHERIT,
+	"constraint": tokenCONSTRAINT,
+	"exclude":    tokenEXCLUDE,
+	"using":      tokenUSING,
+	"include":    tokenINCLUDE,
+	"where":      tokenWHERE,
+	"asc":        tokenASC,
+	"desc":       tokenDESC,
+	"nulls":      tokenNULLS,
+	"first":      tokenFIRST,
+	"last":       tokenLAST,
+	"generated":  tokenGENERATED,
+	"always":     tokenALWAYS,
+	"by":         tokenBY,
+	"identity":   tokenIDENTITY,
+	"stored":     tokenSTORED,
+	"virtual":    tokenVIRTUAL,
+	"start":      tokenSTART,
+	"increment":  tokenINCREMENT,
+	"minvalue":   tokenMINVALUE,
+	"maxvalue":   tokenMAXVALUE,
+	"cache":      tokenCACHE,
+	"cycle":      tokenCYCLE,
+	"sequence":   tokenSEQUENCE,
+	"name":       tokenNAME,
+	"collate":    tokenCOLLATE,
+	"index":      tokenINDEX,
+	"tablespace": tokenTABLESPACE,
+	"temp":       tokenTEMP,
+	"temporary":  tokenTEMPORARY,
+	"unlogged":   tokenUNLOGGED,
+	"global":     tokenGLOBAL,
+	"local":      tokenLOCAL,
+	"commit":     tokenCOMMIT,
+	"preserve":   tokenPRESERVE,
+	"rows":       tokenROWS,
+	"drop":       tokenDROP,
+	"partition":  tokenPARTITION,
+	"of":         tokenOF,
+	"for":        tokenFOR,
+	"values":     tokenVALUES,
+	"options":    tokenOPTIONS,
+	"inherits":   tokenINHERITS,
+	"including":  tokenINCLUDING,
+	"excluding":  tokenEXCLUDING,
+	"oids":       tokenOIDS,
+	"type":       tokenTYPE,
+
+	"select":       tokenSELECT,
+	"into":         tokenINTO,
+	"materialized": tokenMATERIALIZED,
+	"view":         tokenVIEW,
+	"data":         tokenDATA,
+	"server":       tokenSERVER,
+}
+
+// reservedKeywords are the keywords which are in neither ddl_unreserved_keyword
+// nor ddl_col_name_keyword of the grammar, they can not be a name unless quoted.
+var reservedKeywords = map[tokenType]bool{
+	tokenAND: true, tokenARRAY: true, tokenAS: true, tokenASC: true, tokenCASE: true, tokenCAST: true,
+	tokenCHECK: true, tokenCOLLATE: true, tokenCONSTRAINT: true, tokenCreate: true, tokenDEFAULT: true,
+	tokenDEFERRABLE: true, tokenDESC: true, tokenDISTINCT: true, tokenELSE: true, tokenEND: true,
+	tokenEXCLUDE: true, tokenFALSE: true, tokenFOR: true, tokenFOREIGN: true, tokenFROM: true,
+	tokenFULL: true, tokenILIKE: true, tokenIN: true, tokenINITIALLY: true, tokenINTO: true, tokenIS: true,
+	tokenISNULL: true, tokenLIKE: true, tokenNOT: true, tokenNOTNULL: true, tokenNULL: true, tokenON: true,
+	tokenOR: true, tokenPRIMARY: true, tokenREFERENCES: true, tokenSELECT: true, tokenTHEN: true,
+	tokenTO: true, tokenTRUE: true, tokenTable: true, tokenUNIQUE: true, tokenUSING: true, tokenWHEN: true,
+	tokenWHERE: true, tokenWITH: true,
+}
+
+var operators = map[string]tokenType{
+	"+":  tokenPlus,
+	"-":  tokenMinus,
+	"*":  tokenStar,
+	"/":  tokenSlash,
+	"%":  tokenPercent,
+	"^":  tokenCaret,
+	"<":  tokenLess,
+	">":  tokenGreater,
+	"=":  tokenEquals,
+	"<=": tokenLessEquals,
+	">=": tokenGreaterEquals,
+	"<>": tokenNotEquals,
+	"!=": tokenNotEquals,
 }
 
 // stateFn represents the state of the scanner as a function that returns the next state.
@@ -71,6 +224,15 @@ type lexer struct {
 	startLine int            // line of the start Pos
 	lerror    error          // last error
 	ast       []*TableDefine // the final result ast tree
+	scanned   []token        // tokens read by the parser, comments are kept here as trivia
+
+	types []*compositeType // composite types declared by CREATE TYPE
+
+	statement []tokenType // first two tokens of the current statement
+	depth     int         // paren depth in the current statement
+	queryNext bool        // the next token begins a query
+	pending   []token     // scanned tokens to be read again
+	lastPos   Pos         // position of the last token read by the parser
 }
 
 func (l *lexer) next() rune {
@@ -109,13 +271,13 @@ func (l *lexer) peek() rune {
 
 // emit passes an item back to the client.
 func (l *lexer) emit(t tokenType) {
-	new_t := token{t, l.start, l.input[l.start:l.pos], l.startLine}
+	new_t := token{t, l.start, l.input[l.start:l.pos], l.startLine, l.pos}
 	// TODO: use cache to solve the problem
 	switch t {
 	case tokenPgSymbol:
-		new_t.val = strings.ReplaceAll(new_t.val, "\"\"", "\"")
+		new_t.val = strings.ReplaceAll(unquote(new_t.val), "\"\"", "\"")
 	case tokenPgValue:
-		new_t.val = strings.ReplaceAll(new_t.val, "''", "'")
+		new_t.val = strings.ReplaceAll(unquote(new_t.val), "''", "'")
 	}
 	l.tokens <- new_t
 	l.goOnNext()
@@ -179,7 +341,7 @@ func (l *lexer) acceptDigit() {
 // errorf returns an error token and terminates the scan by passing
 // back a nil pointer that will be the next state, terminating l.nextItem.
 func (l *lexer) errorf(format string, args ...interface{}) stateFn {
-	l.tokens <- token{tokenError, l.start, fmt.Sprintf(format, args...), l.startLine}
+	l.tokens <- token{tokenError, l.start, fmt.Sprintf(format, args...), l.startLine, l.pos}
 	return nil
 }
 
@@ -263,31 +425,123 @@ func (l *lexer) skipBlank() {
 }
 
 func (l *lexer) Lex(lval *yySymType) int {
-	token := l.nextToken()
+	token := l.nextSignificant()
+	if l.queryNext && token.typ != tokenSemicolon && token.typ != tokenEOF && token.typ != tokenError {
+		token = l.scanQuery(token, l.statement[0] == tokenCreate)
+	}
+	l.queryNext = false
+	l.lastPos = token.pos
 	switch token.typ {
 	case tokenError:
-		fallthrough
+		l.errorAt(token.pos, "%s", token.val)
+		return 0
 	case tokenEOF:
 		return 0
 	}
+	l.trackStatement(token)
 	lval.stringVal = token.val
+	lval.pos = token.pos
 	return int(token.typ)
 }
 
-func (l *lexer) Error(s string) {
-	columnPos := 1
-	i := int(l.pos)
-	if i >= len(l.input) {
-		i = len(l.input) - 1
+// nextSignificant returns the next token which is not a comment,
+// tokens put back by scanQuery come first and are already scanned.
+func (l *lexer) nextSignificant() token {
+	if len(l.pending) > 0 {
+		token := l.pending[0]
+		l.pending = l.pending[1:]
+		return token
 	}
-	for i >= 0 {
-		if l.input[i] == '\n' {
-			break
+	token := l.nextToken()
+	for token.typ == tokenComment {
+		l.scanned = append(l.scanned, token)
+		token = l.nextToken()
+	}
+	if token.typ != tokenError {
+		l.scanned = append(l.scanned, token)
+	}
+	return token
+}
+
+// trackStatement follows the beginning and the parens of the current statement,
+// the query of CREATE TABLE AS, CREATE MATERIALIZED VIEW and SELECT, which may begin with WITH, is read as one
+// token because the parser does not analyse queries.
+func (l *lexer) trackStatement(token token) {
+	switch token.typ {
+	case tokenSemicolon:
+		l.statement = l.statement[:0]
+		l.depth = 0
+		return
+	case tokenLeftParen:
+		l.depth++
+	case tokenRightParen:
+		l.depth--
+	}
+	if len(l.statement) < 2 {
+		l.statement = append(l.statement, token.typ)
+	}
+	if l.depth != 0 {
+		return
+	}
+	switch {
+	case len(l.statement) == 1 && (token.typ == tokenSELECT || token.typ == tokenWITH):
+		l.queryNext = true
+	case l.statement[0] == tokenCreate && len(l.statement) == 2 && l.statement[1] != tokenTYPE && token.typ == tokenAS:
+		l.queryNext = true
+	}
+}
+
+// lastEnd returns the end position of the last token read by the parser,
+// skip the lookahead token if the parser has read one.
+func (l *lexer) lastEnd(lookahead bool) Pos {
+	for i := len(l.scanned) - 1; i >= 0; i-- {
+		if l.scanned[i].typ == tokenComment {
+			continue
 		}
-		columnPos++
-		i--
+		if lookahead {
+			lookahead = false
+			continue
+		}
+		return l.scanned[i].end
+	}
+	return 0
+}
+
+// quoted reports whether the name read at the position is a quoted identifier.
+func (l *lexer) quoted(pos Pos) bool {
+	return l.input[pos] == '"'
+}
+
+// nameKey returns the name read at the position as postgres looks it up,
+// an unquoted name is folded to lower case.
+func (l *lexer) nameKey(pos Pos, name string) string {
+	if l.quoted(pos) {
+		return name
+	}
+	return strings.ToLower(name)
+}
+
+// source returns the input text of an expression.
+func (l *lexer) source(e Expr) string {
+	return l.input[e.Pos():e.End()]
+}
+
+// Error reports a syntax error at the last token read by the parser.
+func (l *lexer) Error(s string) {
+	l.errorAt(l.lastPos, "%s", s)
+}
+
+// errorAt reports an error found after parsing a part of the input,
+// the line and column are computed from the position of the part.
+func (l *lexer) errorAt(pos Pos, format string, args ...interface{}) {
+	if l.lerror != nil {
+		// keep the first error, the parser reports a syntax error after a lexing error
+		return
 	}
-	l.lerror = fmt.Errorf("%s near line:%d column:%d", s, l.startLine, columnPos)
+	before := l.input[:pos]
+	line := strings.Count(before, "\n") + 1
+	column := int(pos) - strings.LastIndex(before, "\n")
+	l.lerror = fmt.Errorf("%s near line:%d column:%d", fmt.Sprintf(format, args...), line, column)
 }
 
 // lex creates a new scanner for the input string.
@@ -317,11 +571,18 @@ func lexText(l *lexer) stateFn {
 			l.emit(t)
 		} else {
 			switch true {
+			case r == '-' && l.peek() == '-':
+				return lexLineComment
+			case r == '/' && l.peek() == '*':
+				return lexBlockComment
+			case r == ':' && l.peek() == ':':
+				l.next()
+				l.emit(tokenTypeCast)
+			case isOperatorChar(r):
+				return lexOperator
 			case r == '\'':
-				l.ignore() // ignore the '
 				return lexPgValue
-			case r == '"': // ignore the "
-				l.ignore()
+			case r == '"':
 				return lexPgSymbol
 			case isLetter(r):
 				l.backup()
@@ -355,55 +616,103 @@ func lexDigit(l *lexer) stateFn {
 }
 
 func lexPgSymbol(l *lexer) stateFn {
-	for {
-		for {
-			r := l.next()
-			if r == '"' {
-				break
-			} else if r == eof {
-				l.emit(tokenEOF)
-				return nil
-			}
+	if !l.scanQuoted('"') {
+		return l.errorf("unterminated quoted identifier")
+	}
+	l.emit(tokenPgSymbol)
+	return lexText
+}
+
+func lexPgValue(l *lexer) stateFn {
+	if !l.scanQuoted('\'') {
+		return l.errorf("unterminated quoted string")
+	}
+	l.emit(tokenPgValue)
+	return lexText
+}
+
+// lexOperator scans an operator, a comment may start right after it.
+func lexOperator(l *lexer) stateFn {
+	for isOperatorChar(l.peek()) {
+		rest := l.input[l.pos:]
+		if strings.HasPrefix(rest, "--") || strings.HasPrefix(rest, "/*") {
+			break
 		}
-		// is escape?
-		r := l.next()
-		if r != '"' {
-			l.backup()
+		l.next()
+	}
+	op := l.input[l.start:l.pos]
+	// like postgres, a multi-rune operator can not end in + or - unless it
+	// contains one of ~ ! @ # % ^ & | ` ?, so that a*-1 works
+	if !strings.ContainsAny(op, "~!@#%^&|`?") {
+		for len(op) > 1 && strings.ContainsAny(op[len(op)-1:], "+-") {
 			l.backup()
-			l.emit(tokenPgSymbol)
-			l.next()
-			l.ignore()
-			break
+			op = op[:len(op)-1]
 		}
 	}
+	if t, found := operators[op]; found {
+		l.emit(t)
+	} else {
+		l.emit(tokenOp)
+	}
 	return lexText
 }
 
-func lexPgValue(l *lexer) stateFn {
+// lexLineComment scans a -- comment to the end of the line.
+func lexLineComment(l *lexer) stateFn {
 	for {
-		for {
-			r := l.next()
-			if r == '\'' {
-				break
-			} else if r == eof {
-				l.emit(tokenEOF)
-				return nil
-			}
-		}
-		// is  escape?
 		r := l.next()
-		if r != '\'' {
-			l.backup()
+		if r == eof {
+			break
+		}
+		if isEndOfLine(r) {
 			l.backup()
-			l.emit(tokenPgValue)
-			l.next()
-			l.ignore()
 			break
 		}
 	}
+	l.emit(tokenComment)
+	return lexText
+}
+
+// lexBlockComment scans a /* */ comment, postgres allows them to be nested.
+func lexBlockComment(l *lexer) stateFn {
+	l.next() // the *
+	depth := 1
+	for depth > 0 {
+		switch r := l.next(); {
+		case r == eof:
+			return l.errorf("unterminated /* comment")
+		case r == '/' && l.peek() == '*':
+			l.next()
+			depth++
+		case r == '*' && l.peek() == '/':
+			l.next()
+			depth--
+		}
+	}
+	l.emit(tokenComment)
 	return lexText
 }
 
+// scanQuoted scans to the closing quote, a doubled quote is an escaped one.
+func (l *lexer) scanQuoted(quote rune) bool {
+	for {
+		switch l.next() {
+		case eof:
+			return false
+		case quote:
+			if l.peek() != quote {
+				return true
+			}
+			l.next()
+		}
+	}
+}
+
+// unquote strips the surrounding quotes of a quoted token.
+func unquote(s string) string {
+	return s[1 : len(s)-1]
+}
+
 // isSpace reports whether r is a space character.
 func isSpace(r rune) bool {
 	return r == ' ' || r == '\t'
@@ -420,6 +729,11 @@ func isEndOfLine(r rune) bool {
 	return r == '\r' || r == '\n'
 }
 
+// isOperatorChar reports whether r can be a part of an operator.
+func isOperatorChar(r rune) bool {
+	return r != eof && strings.ContainsRune("+-*/<>=~!@#%^&|`?", r)
+}
+
 // isAlphaNumeric reports whether r is an alphabetic, digit, or underscore.
 func isAlphaNumeric(r rune) bool {
 	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
@@ -65,6 +65,51 @@ var lexTests = []lexTest{
 			vEOF,
 		},
 	},
+	{
+		"scan array type", "text[3]", []token{
+			mkToken(tokenString, "text"),
+			mkToken(tokenLeftBracket, "["),
+			mkToken(tokenNumber, "3"),
+			mkToken(tokenRightBracket, "]"),
+			vEOF,
+		},
+	},
+	{"line comment", "-- added for ticket\nCREATE", []token{
+		mkToken(tokenComment, "-- added for ticket"),
+		vCREATE,
+		vEOF,
+	}},
+	{"nested block comment", "/* a /* b */ c */TABLE", []token{
+		mkToken(tokenComment, "/* a /* b */ c */"),
+		vTABLE,
+		vEOF,
+	}},
+	{"minus is not comment", "-1", []token{
+		mkToken(tokenMinus, "-"),
+		mkToken(tokenNumber, "1"),
+		vEOF,
+	}},
+	{"scan operators", "a*-1 <= b && c::int", []token{
+		mkToken(tokenString, "a"),
+		mkToken(tokenStar, "*"),
+		mkToken(tokenMinus, "-"),
+		mkToken(tokenNumber, "1"),
+		mkToken(tokenLessEquals, "<="),
+		mkToken(tokenString, "b"),
+		mkToken(tokenOp, "&&"),
+		mkToken(tokenString, "c"),
+		mkToken(tokenTypeCast, "::"),
+		mkToken(tokenString, "int"),
+		vEOF,
+	}},
+	{"operator before comment", "=--c", []token{
+		mkToken(tokenEquals, "="),
+		mkToken(tokenComment, "--c"),
+		vEOF,
+	}},
+	{"unterminated block comment", "/* a /* b */", []token{
+		mkToken(tokenError, "unterminated /* comment"),
+	}},
 }
 
 func collect(t *lexTest) (tokens []token) {
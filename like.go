@@ -0,0 +1,162 @@
+package tableParser
+
+import (
+	"fmt"
+	"strings"
+)
+
+//LikeOption what a LIKE table element copies besides the column names, types and NOT NULL constraints
+type LikeOption uint
+
+//options of LIKE, given by INCLUDING and EXCLUDING
+const (
+	LikeComments LikeOption = 1 << iota
+	LikeCompression
+	LikeConstraints
+	LikeDefaults
+	LikeGenerated
+	LikeIdentity
+	LikeIndexes
+	LikeStatistics
+	LikeStorage
+	LikeAll LikeOption = 1<<iota - 1
+)
+
+var likeOptions = map[string]LikeOption{
+	"comments":    LikeComments,
+	"compression": LikeCompression,
+	"constraints": LikeConstraints,
+	"defaults":    LikeDefaults,
+	"generated":   LikeGenerated,
+	"identity":    LikeIdentity,
+	"indexes":     LikeIndexes,
+	"statistics":  LikeStatistics,
+	"storage":     LikeStorage,
+	"all":         LikeAll,
+}
+
+//LikeClause a LIKE table element
+type LikeClause struct {
+	Source   TableName
+	Options  LikeOption
+	Expanded bool // the source is defined before in the same input and copied into the table
+
+	at        int       // number of the columns before the element
+	sourceKey TableName // Source as postgres looks it up
+}
+
+//Has whether the option is included
+func (c *LikeClause) Has(option LikeOption) bool {
+	return c.Options&option == option
+}
+
+//setLikeOption apply an INCLUDING or EXCLUDING option
+func (l *lexer) setLikeOption(clause *LikeClause, pos Pos, including bool, name string) {
+	option, found := likeOptions[strings.ToLower(name)]
+	if !found {
+		l.errorAt(pos, "unrecognized LIKE option %q", name)
+		return
+	}
+	if including {
+		clause.Options |= option
+	} else {
+		clause.Options &^= option
+	}
+}
+
+//expandLikes copy the columns and constraints of the LIKE sources defined before the tables
+func expandLikes(defs []*TableDefine) error {
+	for i, def := range defs {
+		// later elements first, so the positions of the former ones are kept
+		for j := len(def.Likes) - 1; j >= 0; j-- {
+			like := def.Likes[j]
+			source := findTable(defs[:i], like.sourceKey)
+			if source == nil {
+				continue
+			}
+			columns, err := source.EffectiveColumns()
+			if err != nil {
+				return fmt.Errorf("LIKE %s of table %q: %s", like.Source, def.Table, err)
+			}
+			if err := def.expandLike(like, source, columns); err != nil {
+				return err
+			}
+			like.Expanded = true
+		}
+		if len(def.Likes) > 0 {
+			def.Constraint.nameConstraints(def.Table)
+		}
+	}
+	return nil
+}
+
+func (d *TableDefine) expandLike(like *LikeClause, source *TableDefine, sourceColumns []*TableColumn) error {
+	copied := []*TableColumn{}
+	notNulls := []*NotNull{}
+	for _, column := range sourceColumns {
+		for _, existing := range d.Columns {
+			if existing.Name == column.Name {
+				return fmt.Errorf("column %q specified more than once in table %q", column.Name, d.Table)
+			}
+		}
+		c := &TableColumn{
+			Name:            column.Name,
+			Type:            column.Type,
+			CanonicalType:   column.CanonicalType,
+			DataType:        column.DataType,
+			Collation:       column.Collation,
+			CollationSchema: column.CollationSchema,
+			Nullable:        column.Nullable,
+		}
+		if like.Has(LikeDefaults) {
+			c.Default, c.DefaultExpr = column.Default, column.DefaultExpr
+		}
+		if like.Has(LikeGenerated) {
+			c.Generated, c.GeneratedExpr, c.GeneratedStored = column.Generated, column.GeneratedExpr, column.GeneratedStored
+		}
+		if like.Has(LikeIdentity) {
+			c.Identity = column.Identity
+		}
+		if like.Has(LikeComments) {
+			c.Comment = column.Comment
+		}
+		copied = append(copied, c)
+		if !c.Nullable && c.Identity == nil {
+			notNulls = append(notNulls, &NotNull{Column: c.Name})
+		}
+	}
+	columns := append([]*TableColumn{}, d.Columns[:like.at]...)
+	columns = append(columns, copied...)
+	d.Columns = append(columns, d.Columns[like.at:]...)
+
+	// copied constraints are named for the new table, except CHECK constraints which keep their names
+	c := d.Constraint
+	c.NotNulls = append(c.NotNulls, notNulls...)
+	if like.Has(LikeConstraints) {
+		for _, check := range source.Constraint.Checks {
+			copied := *check
+			c.Checks = append(c.Checks, &copied)
+		}
+	}
+	if like.Has(LikeIndexes) {
+		if pk := source.Constraint.PrimaryKey; pk != nil {
+			if c.PrimaryKey != nil {
+				return fmt.Errorf("multiple primary keys for table %q are not allowed", d.Table)
+			}
+			copied := *pk
+			copied.Name = ""
+			c.PrimaryKey = &copied
+		}
+		for _, unique := range source.Constraint.Uniques {
+			copied := *unique
+			copied.Name = ""
+			c.Uniques = append(c.Uniques, &copied)
+		}
+		for _, exclusion := range source.Constraint.Exclusions {
+			copied := *exclusion
+			copied.Name = ""
+			c.Exclusions = append(c.Exclusions, &copied)
+		}
+	}
+	return nil
+}
@@ -1,4 +1,4 @@
-// Code generated by goyacc -o parser.go parser.y. DO NOT EDIT.
+// Code generated by goyacc -o parser.go -v /tmp/y.out parser.y. DO NOT EDIT.
 
 //line parser.y:2
 package tableParser
@@ -7,16 +7,52 @@ import __yyfmt__ "fmt"
 
 //line parser.y:2
 
-//line parser.y:6
+import "strings"
+
+// ruleEnd returns the end position of the rule being reduced,
+// char is the lookahead token of the parser, -1 if it has not been read.
+func ruleEnd(yylex yyLexer, char int) Pos {
+	return yylex.(*lexer).lastEnd(char >= 0)
+}
+
+//line parser.y:14
 type yySymType struct {
-	yys          int
-	stringVal    string
-	stringsVal   []string
-	boolVal      bool
-	column       columnObj
-	t_constraint TableConstraint
-	t_header     tableHeader
-	t_body       tableBody
+	yys            int
+	stringVal      string
+	stringsVal     []string
+	intsVal        []int
+	boolVal        bool
+	column         columnObj
+	t_constraint   TableConstraint
+	t_header       tableHeader
+	t_body         tableBody
+	dataType       *DataType
+	pos            Pos
+	expr           Expr
+	exprs          []Expr
+	caseWhen       *CaseWhen
+	caseWhens      []*CaseWhen
+	foreignKey     *ForeignKey
+	check          *Check
+	exclusion      *Exclusion
+	exclusionElem  *ExclusionElem
+	exclusionElems []*ExclusionElem
+	storageParams  StorageParameters
+	seqOptions     *SequenceOptions
+	unique         *Unique
+	indexParams    IndexParameters
+	t_options      tableOptions
+	partitionSpec  *PartitionSpec
+	partitionKey   *PartitionKey
+	partitionKeys  []*PartitionKey
+	partitionBound *PartitionBound
+	t_headers      []tableHeader
+	like           *LikeClause
+	typeAttributes []*TableColumn
+	genericOptions GenericOptions
+	qualified      qualifiedName
+	attribute      constraintAttribute
+	attributes     []constraintAttribute
 }
 
 const tokenError = 57346
@@ -28,20 +64,156 @@ const tokenPgSymbol = 57351
 const tokenPgValue = 57352
 const tokenLeftParen = 57353
 const tokenRightParen = 57354
-const tokenComma = 57355
-const tokenSemicolon = 57356
-const tokenDot = 57357
-const tokenKeyword = 57358
-const tokenCreate = 57359
-const tokenTable = 57360
-const tokenIF = 57361
-const tokenNOT = 57362
-const tokenEXISTS = 57363
-const tokenNULL = 57364
-const tokenDEFAULT = 57365
-const tokenUNIQUE = 57366
-const tokenPRIMARY = 57367
-const tokenKEY = 57368
+const tokenLeftBracket = 57355
+const tokenRightBracket = 57356
+const tokenComma = 57357
+const tokenSemicolon = 57358
+const tokenDot = 57359
+const tokenTypeCast = 57360
+const tokenPlus = 57361
+const tokenMinus = 57362
+const tokenStar = 57363
+const tokenSlash = 57364
+const tokenPercent = 57365
+const tokenCaret = 57366
+const tokenLess = 57367
+const tokenGreater = 57368
+const tokenEquals = 57369
+const tokenLessEquals = 57370
+const tokenGreaterEquals = 57371
+const tokenNotEquals = 57372
+const tokenOp = 57373
+const tokenComment = 57374
+const tokenQuery = 57375
+const tokenKeyword = 57376
+const tokenCreate = 57377
+const tokenTable = 57378
+const tokenIF = 57379
+const tokenNOT = 57380
+const tokenEXISTS = 57381
+const tokenNULL = 57382
+const tokenDEFAULT = 57383
+const tokenUNIQUE = 57384
+const tokenPRIMARY = 57385
+const tokenKEY = 57386
+const tokenARRAY = 57387
+const tokenTRUE = 57388
+const tokenFALSE = 57389
+const tokenDOUBLE = 57390
+const tokenPRECISION = 57391
+const tokenCHARACTER = 57392
+const tokenCHAR = 57393
+const tokenVARYING = 57394
+const tokenNATIONAL = 57395
+const tokenNCHAR = 57396
+const tokenBIT = 57397
+const tokenTIMESTAMP = 57398
+const tokenTIME = 57399
+const tokenWITH = 57400
+const tokenWITHOUT = 57401
+const tokenZONE = 57402
+const tokenINTERVAL = 57403
+const tokenYEAR = 57404
+const tokenMONTH = 57405
+const tokenDAY = 57406
+const tokenHOUR = 57407
+const tokenMINUTE = 57408
+const tokenSECOND = 57409
+const tokenTO = 57410
+const tokenAND = 57411
+const tokenOR = 57412
+const tokenIS = 57413
+const tokenISNULL = 57414
+const tokenNOTNULL = 57415
+const tokenUNKNOWN = 57416
+const tokenDISTINCT = 57417
+const tokenFROM = 57418
+const tokenBETWEEN = 57419
+const tokenIN = 57420
+const tokenLIKE = 57421
+const tokenILIKE = 57422
+const tokenCASE = 57423
+const tokenWHEN = 57424
+const tokenTHEN = 57425
+const tokenELSE = 57426
+const tokenEND = 57427
+const tokenCAST = 57428
+const tokenAS = 57429
+const tokenROW = 57430
+const tokenFOREIGN = 57431
+const tokenREFERENCES = 57432
+const tokenMATCH = 57433
+const tokenFULL = 57434
+const tokenPARTIAL = 57435
+const tokenSIMPLE = 57436
+const tokenON = 57437
+const tokenDELETE = 57438
+const tokenUPDATE = 57439
+const tokenCASCADE = 57440
+const tokenRESTRICT = 57441
+const tokenSET = 57442
+const tokenNO = 57443
+const tokenACTION = 57444
+const tokenDEFERRABLE = 57445
+const tokenINITIALLY = 57446
+const tokenDEFERRED = 57447
+const tokenIMMEDIATE = 57448
+const tokenCHECK = 57449
+const tokenINHERIT = 57450
+const tokenCONSTRAINT = 57451
+const tokenEXCLUDE = 57452
+const tokenUSING = 57453
+const tokenINCLUDE = 57454
+const tokenWHERE = 57455
+const tokenASC = 57456
+const tokenDESC = 57457
+const tokenNULLS = 57458
+const tokenFIRST = 57459
+const tokenLAST = 57460
+const tokenGENERATED = 57461
+const tokenALWAYS = 57462
+const tokenBY = 57463
+const tokenIDENTITY = 57464
+const tokenSTORED = 57465
+const tokenVIRTUAL = 57466
+const tokenSTART = 57467
+const tokenINCREMENT = 57468
+const tokenMINVALUE = 57469
+const tokenMAXVALUE = 57470
+const tokenCACHE = 57471
+const tokenCYCLE = 57472
+const tokenSEQUENCE = 57473
+const tokenNAME = 57474
+const tokenCOLLATE = 57475
+const tokenINDEX = 57476
+const tokenTABLESPACE = 57477
+const tokenTEMP = 57478
+const tokenTEMPORARY = 57479
+const tokenUNLOGGED = 57480
+const tokenGLOBAL = 57481
+const tokenLOCAL = 57482
+const tokenCOMMIT = 57483
+const tokenPRESERVE = 57484
+const tokenROWS = 57485
+const tokenDROP = 57486
+const tokenPARTITION = 57487
+const tokenOF = 57488
+const tokenFOR = 57489
+const tokenVALUES = 57490
+const tokenOPTIONS = 57491
+const tokenINHERITS = 57492
+const tokenINCLUDING = 57493
+const tokenEXCLUDING = 57494
+const tokenOIDS = 57495
+const tokenTYPE = 57496
+const tokenSELECT = 57497
+const tokenINTO = 57498
+const tokenMATERIALIZED = 57499
+const tokenVIEW = 57500
+const tokenDATA = 57501
+const tokenSERVER = 57502
+const tokenEmptyOpclass = 57503
+const tokenUnaryMinus = 57504
 
 var yyToknames = [...]string{
 	"$end",
@@ -56,9 +228,27 @@ var yyToknames = [...]string{
 	"tokenPgValue",
 	"tokenLeftParen",
 	"tokenRightParen",
+	"tokenLeftBracket",
+	"tokenRightBracket",
 	"tokenComma",
 	"tokenSemicolon",
 	"tokenDot",
+	"tokenTypeCast",
+	"tokenPlus",
+	"tokenMinus",
+	"tokenStar",
+	"tokenSlash",
+	"tokenPercent",
+	"tokenCaret",
+	"tokenLess",
+	"tokenGreater",
+	"tokenEquals",
+	"tokenLessEquals",
+	"tokenGreaterEquals",
+	"tokenNotEquals",
+	"tokenOp",
+	"tokenComment",
+	"tokenQuery",
 	"tokenKeyword",
 	"tokenCreate",
 	"tokenTable",
@@ -70,101 +260,1153 @@ var yyToknames = [...]string{
 	"tokenUNIQUE",
 	"tokenPRIMARY",
 	"tokenKEY",
+	"tokenARRAY",
+	"tokenTRUE",
+	"tokenFALSE",
+	"tokenDOUBLE",
+	"tokenPRECISION",
+	"tokenCHARACTER",
+	"tokenCHAR",
+	"tokenVARYING",
+	"tokenNATIONAL",
+	"tokenNCHAR",
+	"tokenBIT",
+	"tokenTIMESTAMP",
+	"tokenTIME",
+	"tokenWITH",
+	"tokenWITHOUT",
+	"tokenZONE",
+	"tokenINTERVAL",
+	"tokenYEAR",
+	"tokenMONTH",
+	"tokenDAY",
+	"tokenHOUR",
+	"tokenMINUTE",
+	"tokenSECOND",
+	"tokenTO",
+	"tokenAND",
+	"tokenOR",
+	"tokenIS",
+	"tokenISNULL",
+	"tokenNOTNULL",
+	"tokenUNKNOWN",
+	"tokenDISTINCT",
+	"tokenFROM",
+	"tokenBETWEEN",
+	"tokenIN",
+	"tokenLIKE",
+	"tokenILIKE",
+	"tokenCASE",
+	"tokenWHEN",
+	"tokenTHEN",
+	"tokenELSE",
+	"tokenEND",
+	"tokenCAST",
+	"tokenAS",
+	"tokenROW",
+	"tokenFOREIGN",
+	"tokenREFERENCES",
+	"tokenMATCH",
+	"tokenFULL",
+	"tokenPARTIAL",
+	"tokenSIMPLE",
+	"tokenON",
+	"tokenDELETE",
+	"tokenUPDATE",
+	"tokenCASCADE",
+	"tokenRESTRICT",
+	"tokenSET",
+	"tokenNO",
+	"tokenACTION",
+	"tokenDEFERRABLE",
+	"tokenINITIALLY",
+	"tokenDEFERRED",
+	"tokenIMMEDIATE",
+	"tokenCHECK",
+	"tokenINHERIT",
+	"tokenCONSTRAINT",
+	"tokenEXCLUDE",
+	"tokenUSING",
+	"tokenINCLUDE",
+	"tokenWHERE",
+	"tokenASC",
+	"tokenDESC",
+	"tokenNULLS",
+	"tokenFIRST",
+	"tokenLAST",
+	"tokenGENERATED",
+	"tokenALWAYS",
+	"tokenBY",
+	"tokenIDENTITY",
+	"tokenSTORED",
+	"tokenVIRTUAL",
+	"tokenSTART",
+	"tokenINCREMENT",
+	"tokenMINVALUE",
+	"tokenMAXVALUE",
+	"tokenCACHE",
+	"tokenCYCLE",
+	"tokenSEQUENCE",
+	"tokenNAME",
+	"tokenCOLLATE",
+	"tokenINDEX",
+	"tokenTABLESPACE",
+	"tokenTEMP",
+	"tokenTEMPORARY",
+	"tokenUNLOGGED",
+	"tokenGLOBAL",
+	"tokenLOCAL",
+	"tokenCOMMIT",
+	"tokenPRESERVE",
+	"tokenROWS",
+	"tokenDROP",
+	"tokenPARTITION",
+	"tokenOF",
+	"tokenFOR",
+	"tokenVALUES",
+	"tokenOPTIONS",
+	"tokenINHERITS",
+	"tokenINCLUDING",
+	"tokenEXCLUDING",
+	"tokenOIDS",
+	"tokenTYPE",
+	"tokenSELECT",
+	"tokenINTO",
+	"tokenMATERIALIZED",
+	"tokenVIEW",
+	"tokenDATA",
+	"tokenSERVER",
+	"tokenEmptyOpclass",
+	"tokenUnaryMinus",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:204
+//line parser.y:2200
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 50,
-	11, 35,
-	15, 35,
-	-2, 37,
+	-1, 299,
+	10, 121,
+	-2, 481,
+	-1, 300,
+	10, 127,
+	11, 127,
+	52, 127,
+	-2, 474,
+	-1, 301,
+	10, 128,
+	11, 128,
+	52, 128,
+	-2, 475,
+	-1, 303,
+	10, 129,
+	11, 129,
+	52, 129,
+	-2, 477,
+	-1, 304,
+	10, 132,
+	11, 132,
+	-2, 478,
+	-1, 305,
+	10, 134,
+	11, 134,
+	58, 134,
+	59, 134,
+	-2, 479,
+	-1, 306,
+	10, 135,
+	11, 135,
+	58, 135,
+	59, 135,
+	-2, 480,
+	-1, 527,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 223,
+	-1, 528,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 224,
+	-1, 529,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 225,
+	-1, 530,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 226,
+	-1, 531,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 227,
+	-1, 532,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 228,
+	-1, 536,
+	77, 0,
+	78, 0,
+	79, 0,
+	80, 0,
+	-2, 233,
+	-1, 541,
+	77, 0,
+	78, 0,
+	79, 0,
+	80, 0,
+	-2, 235,
+	-1, 620,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 264,
+	-1, 621,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 265,
+	-1, 622,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 266,
+	-1, 623,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 267,
+	-1, 624,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 268,
+	-1, 625,
+	25, 0,
+	26, 0,
+	27, 0,
+	28, 0,
+	29, 0,
+	30, 0,
+	-2, 269,
+	-1, 644,
+	77, 0,
+	78, 0,
+	79, 0,
+	80, 0,
+	-2, 234,
+	-1, 645,
+	77, 0,
+	78, 0,
+	79, 0,
+	80, 0,
+	-2, 236,
+	-1, 725,
+	71, 0,
+	72, 0,
+	73, 0,
+	-2, 247,
+	-1, 726,
+	77, 0,
+	78, 0,
+	79, 0,
+	80, 0,
+	-2, 249,
+	-1, 752,
+	71, 0,
+	-2, 271,
+	-1, 763,
+	77, 0,
+	78, 0,
+	79, 0,
+	80, 0,
+	-2, 250,
+	-1, 765,
+	71, 0,
+	72, 0,
+	73, 0,
+	-2, 248,
+	-1, 781,
+	71, 0,
+	-2, 272,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 74
-
-var yyAct = [...]int{
-
-	16, 14, 47, 19, 44, 33, 53, 45, 42, 36,
-	21, 13, 58, 34, 46, 25, 35, 32, 36, 12,
-	40, 17, 17, 18, 18, 27, 9, 6, 39, 41,
-	61, 37, 28, 20, 60, 30, 49, 43, 54, 15,
-	7, 21, 66, 29, 57, 64, 49, 65, 59, 55,
-	56, 22, 23, 50, 52, 18, 51, 3, 62, 26,
-	8, 63, 17, 4, 18, 10, 2, 1, 38, 48,
-	24, 11, 5, 31,
+const yyLast = 4179
+
+var yyAct = [...]int16{
+	296, 818, 162, 556, 297, 356, 284, 573, 782, 746,
+	432, 701, 418, 161, 494, 45, 54, 45, 46, 461,
+	45, 45, 597, 232, 346, 45, 713, 430, 145, 714,
+	225, 206, 247, 139, 427, 234, 369, 203, 165, 223,
+	35, 39, 164, 37, 45, 45, 314, 321, 253, 147,
+	425, 437, 17, 201, 141, 181, 344, 310, 151, 217,
+	48, 18, 42, 189, 22, 290, 40, 702, 50, 198,
+	700, 600, 455, 144, 38, 748, 150, 205, 188, 10,
+	41, 218, 140, 179, 180, 41, 41, 235, 584, 578,
+	140, 148, 439, 138, 312, 448, 587, 586, 319, 183,
+	156, 155, 12, 154, 153, 751, 20, 207, 14, 187,
+	140, 27, 26, 30, 29, 28, 707, 779, 705, 826,
+	821, 822, 227, 823, 228, 229, 236, 68, 699, 24,
+	799, 800, 23, 362, 363, 817, 441, 636, 740, 45,
+	190, 449, 227, 447, 228, 229, 236, 68, 165, 45,
+	45, 45, 164, 45, 770, 771, 687, 688, 738, 348,
+	45, 45, 656, 610, 196, 268, 269, 785, 784, 786,
+	783, 267, 197, 467, 237, 810, 551, 759, 760, 760,
+	45, 45, 209, 210, 212, 759, 215, 758, 219, 147,
+	634, 69, 45, 230, 237, 185, 756, 45, 438, 11,
+	45, 45, 221, 238, 140, 45, 788, 715, 45, 47,
+	68, 69, 202, 230, 140, 140, 140, 233, 140, 716,
+	717, 718, 226, 238, 237, 41, 41, 222, 316, 224,
+	231, 45, 274, 318, 45, 220, 267, 233, 45, 266,
+	21, 315, 15, 16, 45, 264, 265, 341, 635, 501,
+	270, 446, 324, 235, 334, 214, 49, 41, 753, 343,
+	184, 186, 308, 724, 345, 41, 317, 309, 326, 709,
+	323, 360, 45, 325, 69, 332, 45, 70, 653, 327,
+	428, 789, 668, 667, 335, 226, 428, 710, 566, 565,
+	384, 628, 340, 231, 828, 793, 355, 694, 273, 357,
+	359, 381, 513, 140, 539, 540, 537, 538, 436, 373,
+	249, 250, 383, 815, 434, 693, 386, 692, 512, 790,
+	791, 792, 794, 795, 796, 797, 382, 183, 627, 142,
+	143, 45, 598, 572, 45, 272, 558, 41, 472, 445,
+	45, 41, 378, 45, 377, 261, 248, 260, 470, 543,
+	336, 542, 337, 338, 236, 68, 648, 544, 545, 462,
+	466, 193, 649, 650, 262, 263, 440, 465, 244, 341,
+	192, 241, 502, 433, 349, 497, 334, 498, 463, 456,
+	458, 343, 457, 251, 499, 546, 547, 331, 165, 329,
+	651, 652, 164, 249, 250, 152, 41, 811, 812, 140,
+	328, 520, 237, 240, 149, 469, 335, 585, 357, 454,
+	518, 32, 31, 500, 340, 184, 186, 452, 474, 69,
+	474, 339, 45, 387, 561, 387, 480, 564, 819, 393,
+	516, 238, 559, 45, 254, 255, 256, 257, 258, 259,
+	820, 34, 45, 836, 45, 342, 254, 255, 256, 257,
+	258, 259, 45, 689, 45, 575, 45, 492, 45, 45,
+	567, 453, 146, 165, 45, 421, 243, 164, 277, 595,
+	200, 13, 316, 604, 45, 165, 605, 568, 160, 164,
+	839, 350, 419, 660, 583, 582, 638, 562, 613, 590,
+	581, 601, 602, 45, 579, 577, 637, 606, 574, 588,
+	804, 803, 3, 805, 660, 226, 506, 580, 802, 41,
+	611, 660, 776, 231, 612, 777, 33, 323, 365, 596,
+	364, 599, 474, 140, 140, 477, 478, 479, 480, 140,
+	766, 276, 474, 475, 476, 477, 478, 479, 480, 41,
+	661, 662, 642, 643, 640, 639, 387, 388, 389, 390,
+	391, 392, 393, 655, 641, 659, 660, 657, 629, 680,
+	681, 682, 683, 684, 685, 675, 676, 674, 677, 678,
+	679, 673, 436, 504, 767, 834, 45, 660, 434, 503,
+	603, 474, 475, 476, 477, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 670, 367, 764, 514, 671,
+	660, 45, 366, 567, 511, 45, 510, 341, 697, 45,
+	728, 695, 509, 660, 334, 412, 413, 414, 415, 343,
+	727, 208, 416, 660, 704, 387, 388, 389, 390, 391,
+	392, 393, 722, 462, 488, 426, 708, 433, 400, 159,
+	665, 690, 706, 660, 335, 157, 711, 769, 497, 744,
+	498, 723, 340, 663, 630, 607, 660, 631, 608, 165,
+	835, 570, 519, 164, 571, 159, 703, 743, 733, 754,
+	41, 742, 729, 664, 140, 731, 387, 388, 389, 390,
+	391, 392, 393, 720, 409, 410, 403, 405, 387, 400,
+	45, 390, 391, 392, 393, 632, 517, 436, 351, 159,
+	832, 507, 450, 434, 508, 451, 45, 747, 806, 442,
+	385, 750, 443, 159, 158, 749, 696, 159, 370, 372,
+	647, 371, 422, 423, 204, 521, 522, 523, 524, 525,
+	526, 527, 528, 529, 530, 531, 532, 533, 534, 535,
+	536, 757, 541, 755, 423, 45, 772, 773, 549, 19,
+	473, 420, 45, 417, 554, 741, 380, 358, 333, 330,
+	199, 307, 433, 275, 271, 569, 246, 245, 242, 787,
+	194, 357, 576, 191, 424, 698, 515, 505, 436, 45,
+	45, 379, 376, 375, 434, 809, 808, 807, 747, 374,
+	165, 6, 813, 5, 164, 4, 824, 825, 474, 475,
+	476, 477, 478, 479, 480, 2, 45, 351, 1, 557,
+	775, 487, 287, 282, 283, 239, 830, 780, 831, 550,
+	468, 351, 351, 351, 814, 816, 444, 45, 460, 459,
+	464, 313, 774, 745, 489, 490, 491, 45, 837, 311,
+	778, 25, 609, 433, 574, 357, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	493, 9, 8, 761, 719, 798, 404, 361, 737, 347,
+	589, 829, 322, 672, 644, 645, 739, 686, 351, 195,
+	320, 471, 429, 431, 51, 633, 182, 712, 368, 252,
+	169, 548, 833, 168, 171, 167, 163, 401, 402, 406,
+	407, 408, 838, 666, 36, 409, 410, 403, 405, 7,
+	0, 0, 0, 0, 0, 658, 0, 0, 0, 0,
+	0, 0, 0, 387, 388, 389, 390, 391, 392, 393,
+	394, 395, 396, 397, 398, 399, 400, 0, 0, 0,
+	0, 0, 0, 404, 351, 351, 351, 351, 351, 351,
+	351, 351, 351, 351, 351, 351, 351, 614, 615, 616,
+	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
+	0, 0, 0, 721, 401, 402, 406, 407, 408, 0,
+	0, 0, 409, 410, 403, 405, 0, 0, 669, 0,
+	725, 726, 0, 0, 0, 0, 0, 730, 52, 292,
+	53, 291, 285, 732, 0, 735, 736, 0, 351, 0,
+	278, 279, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 646, 280, 0, 0, 0, 0, 0, 71, 281,
+	55, 295, 0, 0, 0, 74, 288, 293, 294, 166,
+	56, 300, 301, 76, 302, 303, 304, 305, 306, 0,
+	77, 78, 299, 79, 80, 81, 82, 83, 84, 763,
+	0, 765, 0, 0, 0, 85, 0, 0, 65, 0,
+	0, 0, 298, 0, 0, 0, 0, 286, 0, 289,
+	0, 0, 86, 0, 87, 88, 0, 89, 90, 91,
+	92, 93, 94, 95, 0, 0, 96, 97, 0, 98,
+	0, 0, 0, 99, 0, 0, 801, 72, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 0, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 73, 0, 67,
+	128, 129, 130, 131, 132, 133, 0, 0, 134, 135,
+	136, 137, 0, 0, 0, 0, 0, 52, 292, 53,
+	291, 285, 0, 419, 555, 0, 0, 0, 0, 278,
+	279, 0, 0, 0, 0, 0, 0, 0, 351, 0,
+	0, 280, 0, 0, 0, 0, 0, 71, 281, 55,
+	295, 752, 0, 0, 74, 288, 293, 294, 166, 56,
+	300, 301, 76, 302, 303, 304, 305, 306, 0, 77,
+	78, 299, 79, 80, 81, 82, 83, 84, 0, 0,
+	0, 0, 351, 0, 85, 0, 0, 65, 0, 0,
+	0, 298, 0, 0, 0, 781, 286, 0, 289, 0,
+	0, 86, 0, 87, 88, 0, 89, 90, 91, 92,
+	93, 94, 95, 0, 0, 96, 97, 0, 98, 0,
+	0, 0, 99, 0, 0, 0, 72, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 0, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 73, 0, 67, 128,
+	129, 130, 131, 132, 133, 0, 0, 134, 135, 136,
+	137, 52, 292, 53, 291, 285, 563, 0, 0, 0,
+	0, 0, 0, 278, 279, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 71, 281, 55, 295, 0, 0, 0, 74, 288,
+	293, 294, 166, 56, 300, 301, 76, 302, 303, 304,
+	305, 306, 0, 77, 78, 299, 79, 80, 81, 82,
+	83, 84, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 65, 0, 0, 0, 298, 0, 0, 0, 0,
+	286, 0, 289, 0, 0, 86, 0, 87, 88, 0,
+	89, 90, 91, 92, 93, 94, 95, 0, 0, 96,
+	97, 0, 98, 0, 0, 0, 99, 0, 0, 0,
+	72, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 0, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	73, 0, 67, 128, 129, 130, 131, 132, 133, 0,
+	0, 134, 135, 136, 137, 52, 292, 53, 291, 285,
+	560, 0, 0, 0, 0, 0, 0, 278, 279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 71, 281, 55, 295, 0,
+	0, 0, 74, 288, 293, 294, 166, 56, 300, 301,
+	76, 302, 303, 304, 305, 306, 0, 77, 78, 299,
+	79, 80, 81, 82, 83, 84, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 65, 0, 0, 0, 298,
+	0, 0, 0, 0, 286, 0, 289, 0, 0, 86,
+	0, 87, 88, 0, 89, 90, 91, 92, 93, 94,
+	95, 0, 0, 96, 97, 0, 98, 0, 0, 0,
+	99, 0, 0, 0, 72, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 0, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 73, 0, 67, 128, 129, 130,
+	131, 132, 133, 0, 0, 134, 135, 136, 137, 52,
+	292, 53, 291, 285, 0, 0, 0, 0, 0, 0,
+	0, 278, 279, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 71,
+	281, 55, 295, 0, 0, 0, 74, 288, 293, 294,
+	166, 56, 300, 301, 76, 302, 303, 304, 305, 306,
+	0, 77, 78, 299, 79, 80, 81, 82, 83, 84,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 65,
+	0, 0, 0, 298, 428, 0, 0, 0, 286, 0,
+	289, 0, 0, 86, 0, 87, 88, 0, 89, 90,
+	91, 92, 93, 94, 95, 0, 0, 96, 97, 0,
+	98, 0, 0, 0, 99, 0, 0, 0, 72, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 0, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 73, 0,
+	67, 128, 129, 130, 131, 132, 133, 0, 0, 134,
+	135, 136, 137, 52, 292, 53, 291, 285, 0, 0,
+	0, 0, 0, 0, 0, 278, 279, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 0, 0,
+	0, 0, 0, 71, 281, 55, 295, 0, 0, 0,
+	74, 288, 293, 294, 166, 56, 300, 301, 76, 302,
+	303, 304, 305, 306, 0, 77, 78, 299, 79, 80,
+	81, 82, 83, 84, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 65, 0, 0, 0, 298, 0, 0,
+	0, 0, 286, 0, 289, 0, 0, 86, 0, 87,
+	88, 0, 89, 90, 91, 92, 93, 94, 95, 0,
+	0, 96, 97, 0, 98, 0, 0, 0, 99, 0,
+	0, 0, 72, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 0,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 73, 0, 67, 128, 129, 130, 131, 132,
+	133, 0, 0, 134, 135, 136, 137, 52, 292, 53,
+	291, 285, 0, 0, 0, 0, 0, 0, 0, 352,
+	353, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 354, 0, 0, 0, 0, 0, 71, 0, 55,
+	295, 0, 0, 0, 74, 288, 293, 294, 166, 56,
+	300, 301, 76, 302, 303, 304, 305, 306, 0, 77,
+	78, 299, 79, 80, 81, 82, 83, 84, 0, 0,
+	0, 0, 0, 0, 85, 0, 0, 65, 0, 0,
+	0, 298, 0, 0, 0, 0, 286, 0, 289, 0,
+	0, 86, 0, 87, 88, 0, 89, 90, 91, 92,
+	93, 94, 95, 0, 0, 96, 97, 0, 98, 0,
+	0, 0, 99, 0, 0, 0, 72, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 0, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 73, 0, 67, 128,
+	129, 130, 131, 132, 133, 0, 0, 134, 135, 136,
+	137, 52, 0, 53, 387, 388, 389, 390, 391, 392,
+	393, 394, 395, 396, 397, 398, 399, 400, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 71, 0, 55, 0, 0, 47, 68, 74, 0,
+	0, 0, 75, 56, 57, 58, 76, 59, 60, 61,
+	62, 63, 0, 77, 78, 64, 79, 80, 81, 82,
+	83, 84, 0, 409, 410, 403, 405, 0, 85, 0,
+	0, 65, 0, 44, 0, 0, 0, 0, 0, 0,
+	0, 0, 66, 49, 0, 86, 0, 87, 88, 0,
+	89, 90, 91, 92, 93, 94, 95, 0, 0, 96,
+	97, 69, 98, 43, 70, 0, 99, 0, 0, 0,
+	72, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 0, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	73, 0, 67, 128, 129, 130, 131, 132, 133, 0,
+	0, 134, 135, 136, 137, 52, 0, 53, 0, 0,
+	0, 0, 0, 0, 0, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 0,
+	0, 0, 0, 0, 0, 71, 0, 55, 0, 0,
+	47, 68, 74, 0, 0, 0, 75, 56, 57, 58,
+	76, 59, 60, 61, 62, 63, 0, 77, 78, 64,
+	79, 80, 81, 82, 83, 84, 654, 0, 488, 0,
+	0, 0, 85, 0, 0, 65, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 66, 49, 0, 86,
+	0, 87, 88, 0, 89, 90, 91, 92, 93, 94,
+	95, 0, 0, 96, 97, 69, 98, 43, 70, 0,
+	99, 0, 0, 0, 72, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 0, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 73, 0, 67, 128, 129, 130,
+	131, 132, 133, 0, 0, 134, 135, 136, 137, 52,
+	0, 53, 0, 435, 0, 0, 0, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 0, 0, 0, 0, 0, 0, 0, 0, 71,
+	0, 55, 0, 0, 0, 0, 74, 0, 0, 0,
+	75, 56, 57, 58, 76, 59, 60, 61, 62, 63,
+	0, 77, 78, 64, 79, 80, 81, 82, 83, 84,
+	488, 0, 0, 0, 0, 0, 85, 0, 0, 65,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	66, 0, 0, 86, 0, 87, 88, 0, 89, 90,
+	91, 92, 93, 94, 95, 0, 0, 96, 97, 0,
+	98, 0, 0, 0, 99, 0, 0, 0, 72, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 0, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 73, 0,
+	67, 128, 129, 130, 131, 132, 133, 0, 0, 134,
+	135, 136, 137, 52, 0, 53, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 71, 0, 55, 0, 0, 0, 0,
+	74, 0, 0, 0, 75, 56, 57, 58, 76, 59,
+	60, 61, 62, 63, 0, 77, 78, 64, 79, 80,
+	81, 82, 83, 84, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 66, 0, 0, 86, 0, 87,
+	88, 0, 89, 90, 91, 92, 93, 94, 95, 0,
+	0, 96, 97, 0, 98, 0, 0, 0, 99, 0,
+	0, 0, 72, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 0,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 73, 0, 67, 128, 129, 130, 131, 132,
+	133, 0, 0, 134, 135, 136, 137, 495, 0, 496,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 0, 55,
+	0, 0, 0, 0, 74, 0, 0, 0, 75, 56,
+	57, 58, 76, 59, 60, 61, 62, 63, 0, 77,
+	78, 64, 79, 80, 81, 82, 83, 84, 0, 0,
+	0, 0, 0, 0, 85, 0, 0, 65, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 66, 0,
+	0, 86, 0, 87, 88, 0, 89, 90, 91, 92,
+	93, 94, 95, 0, 0, 96, 97, 0, 98, 0,
+	0, 0, 99, 0, 0, 0, 72, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 0, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 73, 0, 67, 128,
+	129, 130, 131, 132, 133, 0, 0, 134, 135, 136,
+	137, 52, 0, 53, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 216, 0, 55, 0, 0, 0, 0, 74, 0,
+	0, 0, 75, 56, 57, 58, 76, 59, 60, 61,
+	62, 63, 0, 77, 78, 64, 79, 80, 81, 82,
+	83, 84, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 65, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 66, 0, 0, 86, 0, 87, 88, 0,
+	89, 90, 91, 92, 93, 94, 95, 0, 0, 96,
+	97, 0, 98, 0, 0, 0, 99, 0, 0, 0,
+	72, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 0, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	73, 0, 67, 128, 129, 130, 131, 132, 133, 0,
+	0, 134, 135, 136, 137, 52, 0, 53, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 55, 0, 0,
+	0, 0, 74, 0, 0, 0, 75, 56, 57, 58,
+	76, 59, 60, 61, 62, 63, 0, 77, 78, 64,
+	79, 80, 81, 82, 83, 84, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 65, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 66, 0, 0, 86,
+	0, 87, 88, 0, 89, 90, 91, 92, 93, 94,
+	95, 0, 0, 96, 97, 0, 98, 0, 0, 0,
+	99, 0, 0, 0, 72, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 0, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 73, 0, 67, 128, 129, 130,
+	131, 132, 133, 0, 0, 134, 135, 136, 137, 52,
+	0, 53, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
+	0, 55, 0, 0, 0, 0, 74, 0, 0, 0,
+	75, 56, 57, 58, 76, 59, 60, 61, 62, 63,
+	0, 77, 78, 64, 79, 80, 81, 82, 83, 84,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 65,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	66, 0, 0, 86, 0, 87, 88, 0, 89, 90,
+	91, 92, 93, 94, 95, 0, 0, 96, 97, 0,
+	98, 0, 0, 0, 99, 0, 0, 0, 72, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 0, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 73, 0,
+	67, 128, 129, 130, 131, 132, 133, 0, 0, 134,
+	135, 136, 137, 52, 0, 53, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 71, 0, 55, 0, 0, 0, 0,
+	74, 0, 0, 0, 75, 56, 57, 58, 76, 59,
+	60, 61, 62, 63, 0, 77, 78, 64, 79, 80,
+	81, 82, 83, 84, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 66, 0, 0, 86, 0, 87,
+	88, 0, 89, 90, 91, 92, 93, 94, 95, 0,
+	0, 96, 97, 0, 98, 0, 0, 0, 99, 0,
+	0, 0, 0, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 0,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 73, 0, 67, 128, 129, 130, 131, 132,
+	133, 0, 0, 134, 135, 136, 137, 52, 0, 53,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 387, 388, 389, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 399, 400, 0, 71, 0, 0,
+	0, 0, 404, 0, 74, 0, 0, 0, 166, 0,
+	175, 176, 76, 178, 177, 172, 173, 174, 0, 77,
+	78, 170, 79, 80, 81, 82, 83, 84, 0, 0,
+	0, 0, 0, 401, 85, 406, 407, 408, 0, 0,
+	0, 409, 410, 403, 405, 0, 0, 0, 0, 0,
+	0, 86, 0, 87, 88, 0, 89, 90, 91, 92,
+	93, 94, 95, 0, 0, 96, 97, 0, 98, 0,
+	0, 0, 99, 0, 0, 0, 72, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 0, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 73, 0, 0, 128,
+	129, 130, 131, 132, 133, 0, 0, 134, 135, 136,
+	137, 370, 372, 0, 371, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 591, 0, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	0, 71, 0, 0, 0, 0, 404, 0, 74, 0,
+	592, 593, 75, 0, 0, 0, 76, 0, 0, 0,
+	0, 0, 0, 77, 78, 0, 79, 80, 81, 82,
+	83, 84, 0, 0, 0, 0, 0, 0, 85, 406,
+	407, 408, 0, 0, 0, 409, 410, 403, 405, 0,
+	0, 0, 0, 0, 0, 86, 0, 87, 88, 594,
+	89, 90, 91, 92, 93, 94, 95, 0, 0, 96,
+	97, 0, 98, 0, 0, 0, 99, 0, 0, 0,
+	72, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 0, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	73, 0, 0, 128, 129, 130, 131, 132, 133, 0,
+	0, 134, 135, 136, 137, 387, 388, 389, 390, 391,
+	392, 393, 394, 395, 396, 397, 398, 399, 400, 0,
+	0, 0, 0, 0, 0, 404, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	0, 0, 0, 0, 0, 0, 404, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 401, 402, 406, 407,
+	408, 0, 0, 0, 409, 410, 403, 405, 0, 0,
+	0, 0, 768, 0, 0, 0, 0, 401, 402, 406,
+	407, 408, 0, 0, 0, 409, 410, 403, 405, 0,
+	0, 0, 0, 734, 387, 388, 389, 390, 391, 392,
+	393, 394, 395, 396, 397, 398, 399, 400, 0, 552,
+	0, 0, 553, 0, 404, 387, 388, 389, 390, 391,
+	392, 393, 394, 395, 396, 397, 398, 399, 400, 0,
+	0, 0, 0, 0, 0, 404, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 406, 407, 408,
+	0, 0, 0, 409, 410, 403, 405, 0, 428, 0,
+	0, 0, 0, 0, 0, 0, 401, 402, 406, 407,
+	408, 0, 827, 0, 409, 410, 403, 405, 387, 388,
+	389, 390, 391, 392, 393, 394, 395, 396, 397, 398,
+	399, 400, 0, 762, 0, 0, 0, 0, 404, 387,
+	388, 389, 390, 391, 392, 393, 394, 395, 396, 397,
+	398, 399, 400, 0, 0, 0, 0, 0, 0, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 406, 407, 408, 0, 0, 0, 409, 410, 403,
+	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	401, 402, 406, 407, 408, 0, 691, 0, 409, 410,
+	403, 405, 387, 388, 389, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 399, 400, 0, 411, 0, 0,
+	0, 0, 404, 387, 388, 389, 390, 391, 392, 393,
+	394, 395, 396, 397, 398, 399, 400, 0, 0, 0,
+	0, 0, 0, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 406, 407, 408, 0, 0,
+	0, 409, 410, 403, 405, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 401, 402, 406, 407, 408, 0,
+	0, 0, 409, 410, 403, 405, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	0, 0, 0, 0, 0, 0, 404, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 406,
+	407, 408, 0, 0, 0, 409, 410, 403, 405,
 }
-var yyPact = [...]int{
-
-	10, -1000, 26, -1000, -1000, 49, 8, 10, 15, 14,
-	-1000, 39, -1000, -1000, 55, 48, -1000, -1000, -1000, -1000,
-	5, 17, -1000, 15, -7, 16, 55, -1, 55, -1000,
-	-1000, -16, -1000, -1000, -8, 46, -20, 55, 37, -1000,
-	55, -1000, -1000, -1000, -10, 46, -1000, -1000, -1000, 19,
-	-1000, -1000, -1000, -1000, -1000, -1000, 55, -1000, -1000, -1000,
-	55, 33, -1000, 36, -1000, 30, -1000,
+
+var yyPact = [...]int16{
+	44, -1000, 455, -1000, -1000, -1000, -1000, 97, 738, 95,
+	-25, 379, 378, 44, 2074, -53, 2536, 271, 271, 2536,
+	2074, -55, 368, -82, 2536, 359, -1000, -1000, -33, -36,
+	-1000, -1000, -1000, -1000, 633, 702, 463, 3460, -1000, -1000,
+	-68, -1000, 157, 2536, 2536, -1000, -1000, 24, 762, 326,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 317, 759,
+	53, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2536, 749,
+	453, 117, 713, -76, -28, -1000, 609, 3460, 2536, 3152,
+	2998, 168, 2844, -1000, -1000, -1000, -1000, -69, -1000, 2536,
+	2074, 104, 358, 757, -1000, 449, 319, 756, 755, 335,
+	372, 295, 293, -1000, -1000, -1000, -1000, -1000, 314, 2536,
+	2536, -1000, 157, -1000, -1000, 68, 60, 167, -1000, 753,
+	260, 2536, 752, -1000, 1766, 750, 2536, 749, -51, 2228,
+	2536, -28, -43, -1000, 2536, -1000, 165, 2536, -69, 749,
+	-1000, 362, -1000, 351, 748, -1000, 349, -51, 747, -1000,
+	-1000, -1000, -68, 312, 84, -1000, 47, 334, -1000, 1920,
+	2536, -1000, -1000, 2536, -1000, 746, 24, 2536, 13, 507,
+	505, 588, 711, 2536, -1000, 781, 775, -1000, 774, 287,
+	285, 773, 745, 233, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	157, 2536, -1000, 215, 698, 2536, 4035, -1000, 1766, 1766,
+	1766, 1766, -1000, -1000, -1000, 1766, 742, -1000, 469, 740,
+	448, -1000, -1000, -1000, -1000, -1000, 712, 764, 1612, 372,
+	-1000, -1000, 314, -1000, 293, -1000, -1000, 2382, -1000, 51,
+	-1000, 271, 15, 697, -1000, -1000, 281, -1000, 164, -1,
+	690, -1000, 390, 444, 376, -1000, -88, 51, 343, 341,
+	2536, 339, -1000, 2536, -1000, 47, 133, -1000, 1920, 2536,
+	-1000, -1000, 2536, -1000, -1000, 312, -1000, 280, 739, -1000,
+	2379, -1000, 1920, 1920, 1920, -1000, -1000, 440, 2690, 47,
+	738, 162, -1000, 331, 565, 769, -1000, 492, 689, -1000,
+	-1000, -1000, -1000, -1000, 600, 594, 592, 258, 242, 586,
+	768, 384, -1000, 684, -1000, 47, 650, 3460, 1766, 1766,
+	1766, 1766, 1766, 1766, 1766, 1766, 1766, 1766, 1766, 1766,
+	1766, 1766, 1766, 1766, 227, 1766, 311, -1000, -1000, 1920,
+	737, 75, 407, 407, 528, 3618, 3867, 1766, -1000, 1150,
+	1458, 2536, -1000, 1304, -1000, 204, 3846, -1000, 1766, 649,
+	-1000, 275, 3306, 438, -1000, 1766, 733, -51, -59, -1000,
+	117, 2536, -1000, 2228, 84, -61, 374, -1000, -46, -47,
+	-1000, 2536, 3614, 2536, 274, 2536, -89, 2536, 2536, 568,
+	458, -1000, 3460, 2536, 643, -1000, -1000, -1000, 2379, -1000,
+	-1000, 52, 713, 2536, 3460, 1920, 1920, 1920, 1920, 1920,
+	1920, 1920, 1920, 1920, 1920, 1920, 1920, 1920, 253, 400,
+	400, 514, 2536, 642, 685, -1000, -1000, -1000, -1000, -1000,
+	99, 126, -1000, -1000, 482, 472, -1000, -1000, 711, -1000,
+	-1000, 252, -1000, -1000, -1000, 542, -1000, 47, -1000, 134,
+	-1000, 670, 670, 405, 405, 405, 407, 607, 607, 607,
+	607, 607, 607, 528, 3618, 3464, 658, 1766, 1766, 1920,
+	709, 658, -1000, 316, -1000, -1000, -1000, 202, 2227, 1766,
+	-1000, 54, -1000, 1766, 828, -1000, 541, 526, 4098, -1000,
+	-1000, 641, 662, -1000, 628, -1000, 1766, -1000, 198, 905,
+	47, 2382, 540, 42, 436, 2536, 4014, -1000, 239, -28,
+	705, -1000, -1000, 312, -1000, 274, -1000, -1000, -1000, -1000,
+	-1000, 767, -1000, -1000, -1000, -1000, -1000, -1000, -31, -62,
+	2536, -1000, -1000, -1000, 2536, -15, -1000, -1000, 2536, -1000,
+	-18, -1000, 624, -1000, 504, 504, 402, 402, 402, 400,
+	780, 780, 780, 780, 780, 780, 514, 193, 212, -1000,
+	-1000, 2690, -1000, 112, 127, 672, 1766, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 658, 658, 563, 1766, -1000, -1000,
+	-1000, -1000, 187, 1766, 1766, 608, -1000, 598, 3460, -1000,
+	1766, -1000, 469, -1000, 991, -1000, 3778, -1000, 1766, 1766,
+	45, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 22, -1000, -1000, 2536,
+	662, -1000, 660, 656, 638, -1000, 2382, -1000, -1000, -1000,
+	-84, -1000, -1000, -62, -1000, 2536, -1000, -30, -1000, 1920,
+	182, 659, -1000, 101, 92, 81, -1000, -1000, -1000, -1000,
+	-1000, 3951, 1766, 585, 1766, 2066, 658, -1000, -1000, 518,
+	4098, -1000, -1000, 562, -1000, 3757, 4098, -1000, 636, -1000,
+	37, -1000, 1766, 1766, 2536, 500, -1000, -16, -1000, -1000,
+	-1000, 2536, 2528, 1920, -1000, -1000, 82, -1000, 89, 69,
+	69, 194, 7, 658, -1000, 2066, -1000, -1000, -1000, 1766,
+	-1000, -1000, 496, 489, 488, 700, -1000, 2382, 2536, 2536,
+	-1000, 2528, -1000, 73, -1000, -1000, 357, -1000, -1000, 3460,
+	255, 14, 420, -7, 420, 420, -1000, -13, -1000, -1000,
+	-1000, 3930, -1000, 226, -1000, 2536, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 420, -1000, 420, -1000, -1000, -1000,
+	692, -1000, -1000, -1000, -1000, -1000, 2536, -1000, 564, 652,
+	-1000, -1000, -1000, 426, 1766, -1000, 2536, 468, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 19, 73, 72, 3, 71, 11, 0, 1, 70,
-	69, 68, 5, 67, 66, 57, 63, 2,
+var yyPgo = [...]int16{
+	0, 74, 39, 35, 909, 33, 904, 41, 62, 13,
+	2, 896, 4, 65, 0, 28, 36, 895, 894, 893,
+	890, 32, 889, 48, 16, 18, 40, 888, 52, 887,
+	886, 55, 885, 26, 29, 8, 56, 23, 68, 884,
+	27, 883, 10, 882, 54, 881, 37, 880, 879, 7,
+	877, 876, 873, 872, 870, 869, 47, 868, 867, 865,
+	864, 863, 5, 862, 861, 14, 11, 67, 860, 30,
+	24, 63, 842, 31, 841, 53, 840, 57, 839, 9,
+	833, 51, 832, 69, 831, 441, 59, 830, 66, 46,
+	19, 829, 828, 826, 22, 1, 825, 824, 819, 815,
+	336, 481, 468, 814, 6, 813, 812, 12, 3, 809,
+	34, 50, 60, 808, 805, 502, 795, 793, 791,
 }
-var yyR1 = [...]int{
 
-	0, 13, 14, 14, 15, 15, 16, 3, 3, 4,
-	4, 5, 5, 5, 5, 1, 1, 8, 9, 9,
-	2, 2, 2, 2, 2, 2, 2, 2, 12, 17,
-	17, 17, 6, 11, 11, 7, 7, 10, 10, 10,
+var yyR1 = [...]int8{
+	0, 113, 114, 114, 115, 115, 115, 115, 116, 116,
+	116, 116, 116, 116, 116, 64, 64, 63, 63, 94,
+	94, 94, 118, 118, 117, 91, 91, 92, 92, 90,
+	90, 4, 4, 74, 74, 74, 74, 74, 74, 74,
+	74, 85, 85, 86, 86, 87, 87, 77, 44, 44,
+	44, 73, 73, 78, 78, 80, 80, 79, 76, 76,
+	83, 83, 84, 84, 84, 84, 89, 89, 93, 93,
+	81, 81, 81, 81, 82, 82, 75, 75, 75, 75,
+	5, 5, 6, 6, 6, 6, 6, 6, 88, 88,
+	88, 1, 1, 1, 1, 66, 66, 67, 68, 68,
+	65, 65, 65, 65, 15, 9, 9, 9, 9, 10,
+	10, 10, 11, 11, 12, 12, 12, 12, 12, 12,
+	12, 12, 12, 12, 12, 17, 17, 18, 18, 18,
+	18, 18, 19, 19, 20, 20, 21, 21, 21, 22,
+	22, 23, 23, 23, 23, 23, 23, 99, 99, 99,
+	99, 27, 27, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 112, 69, 71, 71, 71, 70,
+	72, 72, 62, 62, 3, 3, 58, 58, 59, 59,
+	59, 60, 60, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 97, 97, 96, 96,
+	95, 95, 100, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 100, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 101, 102, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 103, 103, 103, 103, 103, 103, 103,
+	104, 104, 104, 104, 105, 105, 106, 106, 106, 106,
+	111, 111, 110, 107, 107, 107, 109, 109, 108, 108,
+	7, 7, 8, 8, 8, 8, 8, 39, 48, 48,
+	43, 43, 40, 41, 42, 42, 42, 49, 49, 49,
+	50, 50, 50, 51, 51, 51, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 55,
+	55, 45, 45, 46, 47, 47, 56, 56, 53, 53,
+	54, 54, 54, 54, 54, 54, 57, 57, 38, 98,
+	98, 37, 28, 28, 32, 32, 32, 32, 29, 29,
+	29, 29, 29, 33, 34, 35, 35, 35, 35, 35,
+	31, 31, 30, 30, 36, 36, 36, 36, 26, 26,
+	13, 13, 14, 14, 14, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 25, 25, 25, 25, 25, 25, 25, 25,
+	25, 25, 25, 25, 25, 16, 16, 16,
 }
-var yyR2 = [...]int{
 
-	0, 1, 3, 1, 1, 0, 4, 3, 6, 1,
-	3, 1, 3, 1, 3, 3, 2, 1, 1, 3,
-	1, 1, 2, 2, 2, 2, 3, 3, 2, 1,
-	5, 3, 4, 1, 3, 1, 1, 1, 1, 1,
+var yyR2 = [...]int8{
+	0, 1, 3, 1, 1, 1, 1, 0, 6, 7,
+	5, 8, 7, 8, 9, 4, 7, 4, 7, 2,
+	3, 0, 2, 2, 7, 1, 0, 1, 3, 2,
+	4, 4, 7, 1, 1, 2, 2, 2, 2, 1,
+	0, 1, 0, 4, 0, 1, 3, 4, 2, 2,
+	0, 2, 0, 6, 0, 1, 3, 3, 2, 0,
+	3, 0, 1, 3, 1, 3, 3, 2, 2, 0,
+	6, 10, 6, 1, 2, 4, 3, 4, 4, 0,
+	1, 3, 1, 3, 1, 3, 1, 3, 2, 3,
+	3, 3, 2, 4, 3, 1, 0, 4, 2, 4,
+	1, 1, 1, 1, 1, 1, 2, 2, 5, 1,
+	4, 1, 1, 3, 2, 1, 4, 1, 4, 2,
+	5, 1, 4, 2, 5, 1, 2, 1, 1, 1,
+	2, 2, 1, 2, 1, 1, 3, 3, 0, 1,
+	3, 1, 1, 1, 1, 1, 1, 2, 3, 3,
+	4, 1, 3, 1, 2, 2, 1, 2, 2, 2,
+	3, 3, 2, 3, 3, 1, 2, 1, 2, 2,
+	3, 1, 2, 2, 2, 3, 2, 3, 0, 3,
+	4, 0, 1, 3, 5, 7, 1, 2, 1, 1,
+	0, 3, 0, 0, 3, 4, 4, 3, 3, 3,
+	3, 3, 2, 3, 4, 6, 1, 0, 1, 0,
+	1, 2, 1, 3, 2, 2, 2, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 3, 4, 3, 4, 3, 4, 2,
+	2, 3, 4, 3, 4, 3, 4, 5, 6, 5,
+	6, 5, 6, 1, 3, 2, 2, 2, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 5, 6, 1, 1, 1, 3, 6, 1, 2,
+	3, 4, 5, 1, 1, 1, 1, 1, 2, 2,
+	3, 4, 5, 6, 1, 3, 3, 5, 4, 6,
+	1, 2, 4, 2, 3, 3, 1, 3, 1, 3,
+	2, 4, 6, 5, 6, 1, 1, 7, 2, 0,
+	1, 3, 3, 4, 1, 1, 3, 1, 3, 0,
+	1, 1, 0, 2, 2, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	0, 2, 0, 3, 1, 3, 3, 1, 1, 3,
+	1, 2, 1, 1, 1, 1, 4, 0, 5, 2,
+	0, 5, 3, 0, 2, 2, 2, 0, 1, 1,
+	2, 2, 0, 3, 3, 2, 1, 1, 2, 2,
+	1, 0, 1, 2, 1, 2, 2, 2, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
 }
-var yyChk = [...]int{
-
-	-1000, -13, -14, -15, -16, -3, 17, 14, 11, 18,
-	-15, -5, -1, -6, -8, 24, -7, 7, 9, -4,
-	19, -7, 12, 13, -9, -7, 11, 20, 15, -1,
-	-6, -2, 24, -12, 20, 23, 25, 15, -11, -8,
-	21, -7, 24, -12, 20, 23, 22, -17, -10, -7,
-	7, 10, 8, 26, -7, 12, 13, -4, 22, -17,
-	15, 11, -8, -7, 12, 11, 12,
+
+var yyChk = [...]int16{
+	-1000, -113, -114, -115, -116, -117, -118, -4, -63, -64,
+	35, 155, 58, 16, 11, 145, 146, -28, -28, 11,
+	11, 145, 89, 157, 154, -74, 137, 136, 140, 139,
+	138, 33, 33, -115, -85, -26, -6, -15, -1, -7,
+	-88, -13, -8, 109, 79, -14, -25, 42, -112, 89,
+	-38, -39, 7, 9, -24, 39, 49, 50, 51, 53,
+	54, 55, 56, 57, 61, 77, 88, 148, 43, 107,
+	110, 37, 116, 146, 44, 48, 52, 59, 60, 62,
+	63, 64, 65, 66, 67, 74, 91, 93, 94, 96,
+	97, 98, 99, 100, 101, 102, 105, 106, 108, 112,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 149, 150,
+	151, 152, 153, 154, 157, 158, 159, 160, 146, -5,
+	-13, -44, 58, 59, -44, -15, -85, -15, 146, 36,
+	158, -5, 36, 137, 136, 137, 136, 12, 12, 15,
+	15, -9, -10, -11, -12, -14, 48, -17, -19, -20,
+	61, -18, 55, 56, 57, 50, 51, 54, 53, 151,
+	152, -31, -30, -36, 103, 38, 104, -13, -5, -71,
+	116, 11, 44, 44, 11, -48, 111, -5, -83, 11,
+	17, -75, 95, -46, 11, 153, -73, 135, 12, -5,
+	-5, 37, -5, 37, 87, -5, 37, -86, 150, -15,
+	-1, -7, -88, -2, -67, -69, -112, 38, 40, 41,
+	109, -38, -37, 133, -3, 149, 42, 90, 119, -99,
+	45, 13, 11, 17, 49, 11, 11, -21, 11, 58,
+	59, 11, -22, -23, 62, 63, 64, 65, 66, 67,
+	52, 52, 50, 51, -13, -13, -36, 103, 105, 106,
+	-8, 11, 75, 38, -26, 11, -100, -102, 19, 20,
+	31, 38, -105, -103, -104, 11, 86, -106, 45, 88,
+	-13, 10, 8, 46, 47, 40, -14, -12, 81, 61,
+	50, 51, 53, 54, 55, 56, 57, 11, -13, -83,
+	-77, -78, 145, -84, -89, -7, -15, -13, -73, 141,
+	-47, -56, -53, -13, 87, -13, -86, -83, 38, 38,
+	11, 38, -77, 11, -69, -112, 38, 40, 41, 109,
+	-38, -37, 133, -3, -36, -2, -70, -55, 112, 40,
+	-101, -102, 19, 20, 31, -13, -62, -13, 11, -71,
+	-5, -58, 120, 121, 13, 13, 14, 8, -27, -16,
+	7, 10, 8, -13, 8, 8, 8, 57, 57, 8,
+	11, 68, -31, -26, 75, 12, -26, 18, 19, 20,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+	31, 69, 70, 79, 38, 80, 71, 72, 73, 77,
+	78, 12, -100, -100, -100, -100, -100, 11, -107, 13,
+	11, 17, 10, 11, 10, -111, -100, -110, 82, -43,
+	-40, -41, -42, -13, -104, 11, -14, -81, 147, 41,
+	-44, 121, 12, 15, -93, 58, 87, 144, 96, 142,
+	12, 15, 27, 17, 33, 160, -81, 39, 39, -91,
+	-92, -90, -15, 39, -87, -5, -70, 40, -101, -13,
+	-62, -45, 58, 11, 18, 19, 20, 21, 22, 23,
+	24, 25, 26, 27, 28, 29, 30, 31, 71, -101,
+	-101, -101, 17, -68, -65, 7, 9, -24, -25, -70,
+	-28, 87, 41, 14, 8, 8, 14, 12, 15, 12,
+	12, 12, 60, 60, 12, 8, -23, 12, -70, 12,
+	-9, -100, -100, -100, -100, -100, -100, -100, -100, -100,
+	-100, -100, -100, -100, -100, -100, -100, 79, 80, 77,
+	78, -100, 40, 38, 46, 47, 74, 75, -101, 11,
+	-98, 101, 12, 15, -100, 14, -108, -109, -100, -107,
+	12, -108, -13, 12, -108, 85, 84, -110, -111, -100,
+	12, 15, 58, -49, -13, 17, -100, -77, 148, -75,
+	-13, -89, -7, -2, 149, 33, 143, 143, -56, -54,
+	-16, 20, 46, 47, 95, -24, -13, -94, 58, -13,
+	160, -5, -5, 12, 15, -9, -5, 12, 15, -72,
+	111, -46, -26, -9, -101, -101, -101, -101, -101, -101,
+	-101, -101, -101, -101, -101, -101, -101, 75, 38, -13,
+	12, 15, 10, -32, 91, 122, 11, 14, 14, -16,
+	-21, 12, -70, -37, -100, -100, -101, 11, 40, 46,
+	47, 74, 75, 76, 69, -108, 108, -108, 87, 14,
+	15, 14, 15, 12, 11, 12, -100, 85, 84, 83,
+	-70, -40, -52, 31, 27, 25, 26, 28, 29, 30,
+	19, 20, 21, 22, 23, 24, -50, 114, 115, 17,
+	-13, 12, 78, 76, 58, -73, 11, -94, 8, 159,
+	101, -66, -67, -13, -90, 133, -5, 134, 12, 76,
+	75, -65, -29, -33, -34, 95, 92, 93, 94, -60,
+	11, -100, 69, -108, 76, -100, -100, 12, 12, -9,
+	-100, -107, 12, -108, 85, -100, -100, -57, 113, -51,
+	116, -13, 11, 11, 11, -80, -79, -42, 159, -66,
+	-62, 135, -101, 76, 10, -34, 95, -33, 95, 96,
+	97, -61, 12, -100, 12, -100, 12, 12, 85, 11,
+	117, 118, -108, -108, -82, -13, 12, 15, -76, 133,
+	-13, -101, -35, 101, 99, 98, 100, -35, 12, 87,
+	125, 126, 127, 101, 128, 129, 130, 131, -59, 123,
+	124, -100, 12, 12, 12, 15, 8, -79, -49, -62,
+	102, 40, 41, -10, -97, 58, -96, 121, -95, 8,
+	20, 127, 128, 130, -95, -95, 132, 12, 68, -13,
+	-95, -95, 8, -13, 11, 8, 17, -108, -13, 12,
 }
-var yyDef = [...]int{
-
-	5, -2, 1, 3, 4, 0, 0, 5, 0, 0,
-	2, 0, 11, 13, 0, 0, 17, 35, 36, 7,
-	0, 9, 6, 0, 16, 18, 0, 0, 0, 12,
-	14, 15, 20, 21, 0, 0, 0, 0, 0, 33,
-	0, 10, 24, 25, 0, 0, 22, 23, 29, 0,
-	-2, 38, 39, 28, 19, 32, 0, 8, 26, 27,
-	0, 0, 34, 0, 31, 0, 30,
+
+var yyDef = [...]int16{
+	7, -2, 1, 3, 4, 5, 6, 373, 373, 0,
+	40, 0, 0, 7, 42, 0, 0, 50, 50, 0,
+	42, 0, 0, 0, 0, 0, 33, 34, 0, 0,
+	39, 22, 23, 2, 0, 0, 41, 398, 82, 84,
+	86, 104, 391, 0, 0, 400, 401, 178, 0, 0,
+	315, 316, 402, 403, 404, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 0, 0,
+	319, 405, 406, 407, 408, 409, 410, 411, 412, 413,
+	414, 415, 416, 417, 418, 419, 420, 421, 422, 423,
+	424, 425, 426, 427, 428, 429, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 439, 440, 441, 442, 443,
+	444, 445, 446, 447, 448, 449, 450, 451, 452, 453,
+	454, 455, 456, 457, 458, 459, 460, 461, 462, 463,
+	464, 465, 466, 467, 468, 469, 470, 471, 0, 61,
+	80, 79, 0, 0, 52, 398, 0, 0, 0, 0,
+	0, 0, 0, 35, 36, 37, 38, 44, 372, 0,
+	0, 92, 105, 109, 111, 112, 409, 115, 117, 138,
+	121, 125, 132, 134, 135, 127, 128, 129, 0, 0,
+	0, 310, 390, 392, 394, 0, 0, 0, 88, 0,
+	0, 0, 0, 174, 0, 0, 0, 61, 54, 0,
+	0, 52, 0, 48, 0, 49, 0, 0, 44, 61,
+	15, 405, 17, 405, 0, 31, 405, 54, 0, 399,
+	83, 85, 87, 91, 94, 153, 350, 0, 156, 0,
+	0, 165, 167, 0, 171, 0, 178, 0, 0, 106,
+	107, 0, 0, 0, 114, 0, 0, 119, 0, 0,
+	0, 0, 123, 139, 141, 142, 143, 144, 145, 146,
+	126, 133, 130, 131, 89, 90, 393, 395, 396, 397,
+	391, 0, 176, 0, 0, 0, 0, 212, 0, 0,
+	0, 0, 273, 274, 275, 0, 0, 278, 0, 483,
+	294, 283, 284, 285, 286, 287, 400, 0, 0, -2,
+	-2, -2, 476, -2, -2, -2, -2, 0, 318, 0,
+	10, 50, 0, 0, 62, 64, 69, 81, 0, 0,
+	0, 354, 357, 358, 0, 51, 0, 0, 0, 0,
+	26, 0, 8, 0, 159, 350, 0, 162, 0, 0,
+	166, 168, 0, 172, 173, 93, 154, 352, 0, 155,
+	157, 253, 0, 0, 0, 158, 169, 182, 0, 350,
+	373, 0, 186, 0, 0, 0, 147, 0, 0, 151,
+	485, 486, 487, 113, 0, 0, 0, 0, 0, 0,
+	0, 0, 311, 0, 177, 350, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 240, 0,
+	0, 370, 214, 215, 216, 232, 0, 0, 279, 0,
+	0, 0, 288, 0, 289, 0, 0, 300, 0, 0,
+	320, 0, 329, 324, 325, 0, 400, 54, 0, 73,
+	79, 0, 60, 0, 67, 0, 0, 76, 0, 0,
+	353, 0, 0, 0, 21, 0, 0, 0, 0, 0,
+	25, 27, 0, 0, 0, 45, 160, 161, 163, 164,
+	170, 181, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	256, 257, 0, 0, 0, 100, 101, 102, 103, 175,
+	377, 0, 187, 149, 0, 0, 148, 110, 0, 116,
+	118, 138, 136, 137, 122, 0, 140, 350, 313, 0,
+	213, 217, 218, 219, 220, 221, 222, -2, -2, -2,
+	-2, -2, -2, 229, 230, 231, -2, 0, 0, 0,
+	0, -2, 237, 0, 241, 243, 245, 0, 0, 0,
+	368, 0, 276, 0, 0, 303, 0, 0, 308, 306,
+	280, 0, 295, 290, 0, 296, 0, 301, 0, 0,
+	350, 0, 0, 332, 327, 0, 0, 9, 0, 52,
+	0, 63, 65, 66, 68, 21, 77, 78, 355, 356,
+	360, 0, 362, 363, 364, 365, 359, 12, 0, 96,
+	0, 16, 18, 24, 0, 29, 32, 43, 0, 179,
+	0, 351, 0, 254, 258, 259, 260, 261, 262, 263,
+	-2, -2, -2, -2, -2, -2, 270, 0, 0, 183,
+	97, 0, 98, 382, 0, 192, 0, 150, 108, 152,
+	120, 124, 312, 314, -2, -2, 0, 0, 238, 242,
+	244, 246, 0, 0, 0, 0, 369, 0, 0, 304,
+	0, 305, 0, 281, 0, 291, 0, 298, 0, 0,
+	367, 321, 322, 336, 337, 338, 339, 340, 341, 342,
+	343, 344, 345, 346, 347, 348, 335, 330, 331, 0,
+	0, 326, 0, 0, 0, 47, 0, 11, 361, 19,
+	0, 13, 95, 96, 28, 0, 46, 0, 349, 0,
+	0, 0, 371, 378, 379, 0, 374, 375, 376, 184,
+	193, 0, 0, 0, 0, -2, -2, 251, 282, 0,
+	309, 307, 292, 0, 297, 0, 302, 317, 0, 323,
+	0, 328, 0, 0, 0, 0, 55, 59, 20, 14,
+	30, 0, -2, 0, 99, 380, 0, 381, 0, 0,
+	0, 0, 190, -2, 252, -2, 277, 293, 299, 0,
+	333, 334, 0, 0, 0, 0, 53, 0, 329, 0,
+	180, -2, 383, 0, 386, 387, 0, 384, 191, 0,
+	207, 209, 0, 0, 0, 0, 202, 0, 185, 188,
+	189, 0, 70, 0, 72, 0, 74, 56, 57, 58,
+	385, 388, 389, 194, 0, 206, 0, 208, 197, 210,
+	0, 198, 200, 203, 199, 201, 0, 366, 0, 0,
+	195, 196, 211, 204, 0, 75, 0, 0, 205, 71,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]uint8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
-	22, 23, 24, 25, 26,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -246,9 +1488,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -258,13 +1500,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -295,30 +1537,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -373,7 +1615,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -384,8 +1626,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -398,7 +1640,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -407,18 +1649,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -440,10 +1682,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -479,7 +1721,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -490,175 +1732,2232 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
 	switch yynt {
 
-	case 6:
+	case 8:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:294
+		{
+			l := yylex.(*lexer)
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, yyDollar[3].t_body, yyDollar[6].t_options)
+			def.setInherits(yyDollar[5].t_headers)
+			l.ast = append(l.ast, def)
+		}
+	case 9:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:301
+		{
+			l := yylex.(*lexer)
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, yyDollar[5].t_body, yyDollar[7].t_options)
+			def.PartitionOf = yyDollar[6].partitionBound
+			def.PartitionOf.ParentSchema = yyDollar[4].t_header.Schema
+			def.PartitionOf.Parent = yyDollar[4].t_header.Table
+			def.PartitionOf.parentKey = yyDollar[4].t_header.key
+			l.ast = append(l.ast, def)
+		}
+	case 10:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:311
+		{
+			l := yylex.(*lexer)
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, yyDollar[4].t_body, yyDollar[5].t_options)
+			def.OfType = &TableName{yyDollar[3].t_header.Schema, yyDollar[3].t_header.Table}
+			def.ofTypeKey = yyDollar[3].t_header.key
+			l.ast = append(l.ast, def)
+		}
+	case 11:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:319
+		{
+			l := yylex.(*lexer)
+			options := tableOptions{with: yyDollar[3].storageParams, onCommit: OnCommitAction(yyDollar[4].stringVal), onCommitPos: yyDollar[4].pos, tablespace: yyDollar[5].stringVal}
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, tableBody{}, options)
+			def.Query = &TableQuery{Kind: QueryCreateTableAs, Columns: yyDollar[2].stringsVal, Text: yyDollar[7].stringVal, NoData: yyDollar[8].boolVal}
+			l.ast = append(l.ast, def)
+		}
+	case 12:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:327
+		{
+			l := yylex.(*lexer)
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, tableBody{}, tableOptions{with: yyDollar[3].storageParams, tablespace: yyDollar[4].stringVal})
+			def.Query = &TableQuery{Kind: QueryMaterializedView, Columns: yyDollar[2].stringsVal, Text: yyDollar[6].stringVal, NoData: yyDollar[7].boolVal}
+			l.ast = append(l.ast, def)
+		}
+	case 13:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:334
+		{
+			l := yylex.(*lexer)
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, yyDollar[3].t_body, tableOptions{})
+			def.setInherits(yyDollar[5].t_headers)
+			l.setForeign(def, yyDollar[7].stringVal, yyDollar[8].genericOptions)
+			l.ast = append(l.ast, def)
+		}
+	case 14:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:342
+		{
+			l := yylex.(*lexer)
+			def := l.newTableDefine(yyDollar[1].pos, yyDollar[1].t_header, yyDollar[5].t_body, tableOptions{})
+			def.PartitionOf = yyDollar[6].partitionBound
+			def.PartitionOf.ParentSchema = yyDollar[4].t_header.Schema
+			def.PartitionOf.Parent = yyDollar[4].t_header.Table
+			def.PartitionOf.parentKey = yyDollar[4].t_header.key
+			l.setForeign(def, yyDollar[8].stringVal, yyDollar[9].genericOptions)
+			l.ast = append(l.ast, def)
+		}
+	case 15:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:62
+//line parser.y:355
 		{
-			columns := []*TableColumn{}
-			constraint := yyDollar[3].t_body.constraint
-			for _, obj := range yyDollar[3].t_body.columns {
-				columns = append(columns, obj.Column())
-				if obj.PrimaryKey {
-					constraint.PrimaryKey = append(constraint.PrimaryKey, obj.Name)
-				}
-				if obj.Unique {
-					constraint.Uniques = append(constraint.Uniques, []string{obj.Name})
-				}
+			yyVAL.t_header = yyDollar[4].t_header
+		}
+	case 16:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:359
+		{
+			yyVAL.t_header = yyDollar[7].t_header
+			yyVAL.t_header.IfNotExists = true
+		}
+	case 17:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:366
+		{
+			yyVAL.t_header = yyDollar[4].t_header
+		}
+	case 18:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:370
+		{
+			yyVAL.t_header = yyDollar[7].t_header
+			yyVAL.t_header.IfNotExists = true
+		}
+	case 19:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:378
+		{
+			yyVAL.boolVal = false
+		}
+	case 20:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:382
+		{
+			yyVAL.boolVal = true
+		}
+	case 21:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:386
+		{
+			yyVAL.boolVal = false
+		}
+	case 22:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:393
+		{
+			l := yylex.(*lexer)
+			if def := l.selectInto(yyDollar[1].pos, yyDollar[2].pos, yyDollar[2].stringVal); def != nil {
+				l.ast = append(l.ast, def)
+			}
+		}
+	case 23:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:400
+		{
+			l := yylex.(*lexer)
+			if def := l.selectInto(yyDollar[1].pos, yyDollar[2].pos, yyDollar[2].stringVal); def != nil {
+				l.ast = append(l.ast, def)
 			}
-			ast := yylex.(*lexer).ast
-			yylex.(*lexer).ast = append(ast, &TableDefine{
-				Schema:     yyDollar[1].t_header.Schema,
-				Table:      yyDollar[1].t_header.Table,
-				Columns:    columns,
-				Constraint: &constraint,
-			})
 		}
-	case 7:
+	case 24:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:409
+		{
+			l := yylex.(*lexer)
+			l.types = append(l.types, &compositeType{pos: yyDollar[1].pos, Schema: yyDollar[3].t_header.Schema, Name: yyDollar[3].t_header.Table, key: yyDollar[3].t_header.key, attributes: yyDollar[6].typeAttributes})
+		}
+	case 26:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:417
+		{
+			yyVAL.typeAttributes = nil
+		}
+	case 27:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:423
+		{
+			yyVAL.typeAttributes = []*TableColumn{yyDollar[1].column.Column()}
+		}
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:84
+//line parser.y:427
 		{
-			yyVAL.t_header = yyDollar[3].t_header
+			yyVAL.typeAttributes = append(yyDollar[1].typeAttributes, yyDollar[3].column.Column())
 		}
-	case 8:
+	case 29:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:433
+		{
+			yyVAL.column = columnObj{pos: yyDollar[1].pos, Name: yyDollar[1].stringVal, DataType: yyDollar[2].dataType}
+		}
+	case 30:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:437
+		{
+			yyVAL.column = columnObj{pos: yyDollar[1].pos, Name: yyDollar[1].stringVal, DataType: yyDollar[2].dataType, Collation: yyDollar[4].qualified.Name, CollationSchema: yyDollar[4].qualified.Schema}
+		}
+	case 31:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:442
+		{
+			yyVAL.t_header = yyDollar[4].t_header
+			yyVAL.t_header.Persistence = Persistence(yyDollar[2].stringVal)
+		}
+	case 32:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:447
+		{
+			yyVAL.t_header = yyDollar[7].t_header
+			yyVAL.t_header.Persistence = Persistence(yyDollar[2].stringVal)
+			yyVAL.t_header.IfNotExists = true
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:456
+		{
+			yyVAL.stringVal = string(PersistenceTemporary)
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:460
+		{
+			yyVAL.stringVal = string(PersistenceTemporary)
+		}
+	case 35:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:464
+		{
+			yyVAL.stringVal = string(PersistenceTemporary)
+		}
+	case 36:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:468
+		{
+			yyVAL.stringVal = string(PersistenceTemporary)
+		}
+	case 37:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:472
+		{
+			yyVAL.stringVal = string(PersistenceTemporary)
+		}
+	case 38:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:476
+		{
+			yyVAL.stringVal = string(PersistenceTemporary)
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:480
+		{
+			yyVAL.stringVal = string(PersistenceUnlogged)
+		}
+	case 40:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:484
+		{
+			yyVAL.stringVal = string(PersistencePermanent)
+		}
+	case 42:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:491
+		{
+			yyVAL.t_body = tableBody{}
+		}
+	case 43:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:497
+		{
+			yyVAL.t_headers = yyDollar[3].t_headers
+		}
+	case 44:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:501
+		{
+			yyVAL.t_headers = nil
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:507
+		{
+			yyVAL.t_headers = []tableHeader{yyDollar[1].t_header}
+		}
+	case 46:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:511
+		{
+			yyVAL.t_headers = append(yyDollar[1].t_headers, yyDollar[3].t_header)
+		}
+	case 47:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:517
+		{
+			yyVAL.t_options = tableOptions{partitionBy: yyDollar[1].partitionSpec, with: yyDollar[2].storageParams, onCommit: OnCommitAction(yyDollar[3].stringVal), onCommitPos: yyDollar[3].pos, tablespace: yyDollar[4].stringVal}
+		}
+	case 48:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:524
+		{
+			yyVAL.storageParams = yyDollar[2].storageParams
+		}
+	case 49:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:528
+		{
+			yyVAL.storageParams = nil
+		}
+	case 50:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:532
+		{
+			yyVAL.storageParams = nil
+		}
+	case 51:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:538
+		{
+			yyVAL.stringVal = yyDollar[2].stringVal
+		}
+	case 52:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:542
+		{
+			yyVAL.stringVal = ""
+		}
+	case 53:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.y:88
+//line parser.y:548
 		{
-			yyVAL.t_header = yyDollar[6].t_header
+			yyVAL.partitionSpec = yylex.(*lexer).newPartitionSpec(yyDollar[3].pos, yyDollar[3].stringVal, yyDollar[5].partitionKeys)
 		}
-	case 9:
+	case 54:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:552
+		{
+			yyVAL.partitionSpec = nil
+		}
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:94
+//line parser.y:558
 		{
-			yyVAL.t_header.Table = yyDollar[1].stringVal
+			yyVAL.partitionKeys = []*PartitionKey{yyDollar[1].partitionKey}
 		}
-	case 10:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:98
+//line parser.y:562
 		{
-			yyVAL.t_header.Schema = yyDollar[1].stringVal
-			yyVAL.t_header.Table = yyDollar[3].stringVal
+			yyVAL.partitionKeys = append(yyDollar[1].partitionKeys, yyDollar[3].partitionKey)
 		}
-	case 11:
+	case 57:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:568
+		{
+			yyVAL.partitionKey = &PartitionKey{Column: yyDollar[1].exclusionElem.Column, Expr: yyDollar[1].exclusionElem.Expr, Source: yyDollar[1].exclusionElem.Source, Collation: yyDollar[2].stringVal, OpClass: yyDollar[3].stringVal}
+		}
+	case 58:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:574
+		{
+			yyVAL.stringVal = yyDollar[2].qualified.String()
+		}
+	case 59:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:578
+		{
+			yyVAL.stringVal = ""
+		}
+	case 60:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:585
+		{
+			yyVAL.t_body = yyDollar[2].t_body
+		}
+	case 61:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:589
+		{
+			yyVAL.t_body = tableBody{}
+		}
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:105
+//line parser.y:595
 		{
-			yyVAL.t_body.columns = []columnObj{yyDollar[1].column}
+			yyVAL.t_body = tableBody{}
+			yyVAL.t_body.addColumn(yyDollar[1].column)
 		}
-	case 12:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:109
+//line parser.y:600
 		{
-			yyVAL.t_body.columns = append(yyVAL.t_body.columns, yyDollar[3].column)
+			yyVAL.t_body.addColumn(yyDollar[3].column)
 		}
-	case 13:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:113
+//line parser.y:604
 		{
-			yyVAL.t_body.constraint = yyDollar[1].t_constraint
+			yyVAL.t_body = tableBody{}
+			yyVAL.t_body.addConstraint(yyDollar[1].pos, yyDollar[1].t_constraint)
 		}
-	case 14:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:117
+//line parser.y:609
 		{
-			yyVAL.t_body.constraint = combineConstraint(yyVAL.t_body.constraint, yyDollar[3].t_constraint)
+			yyVAL.t_body.addConstraint(yyDollar[3].pos, yyDollar[3].t_constraint)
 		}
-	case 15:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:123
+//line parser.y:615
 		{
+			if yyDollar[3].column.constraintName != "" {
+				yylex.(*lexer).errorAt(yyDollar[3].column.constraintPos, "CONSTRAINT %s is not followed by a constraint", yyDollar[3].column.constraintName)
+			}
 			yyVAL.column = yyDollar[3].column
+			yyVAL.column.pos = yyDollar[1].pos
 			yyVAL.column.Name = yyDollar[1].stringVal
-			yyVAL.column.Type = yyDollar[2].stringVal
 		}
-	case 16:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:129
+//line parser.y:624
 		{
-			yyVAL.column.Name = yyDollar[1].stringVal
-			yyVAL.column.Type = yyDollar[2].stringVal
+			yyVAL.column = columnObj{pos: yyDollar[1].pos, Name: yyDollar[1].stringVal}
 		}
-	case 19:
+	case 68:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:630
+		{
+			yyVAL.boolVal = true
+		}
+	case 69:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:634
+		{
+			yyVAL.boolVal = false
+		}
+	case 70:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:640
+		{
+			yyVAL.partitionBound = &PartitionBound{In: yyDollar[5].exprs}
+		}
+	case 71:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:644
+		{
+			yyVAL.partitionBound = &PartitionBound{From: yyDollar[5].exprs, To: yyDollar[9].exprs}
+		}
+	case 72:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:648
+		{
+			yyVAL.partitionBound = yyDollar[5].partitionBound
+			yylex.(*lexer).checkHashBound(yyVAL.partitionBound, yyDollar[5].pos)
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:653
+		{
+			yyVAL.partitionBound = &PartitionBound{Default: true}
+		}
+	case 74:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:659
+		{
+			yyVAL.partitionBound = &PartitionBound{Modulus: -1, Remainder: -1}
+			yylex.(*lexer).setHashBound(yyVAL.partitionBound, yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].stringVal)
+		}
+	case 75:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:664
+		{
+			yylex.(*lexer).setHashBound(yyVAL.partitionBound, yyDollar[3].pos, yyDollar[3].stringVal, yyDollar[4].stringVal)
+		}
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:139
+//line parser.y:670
 		{
-			yyVAL.stringVal = __yyfmt__.Sprintf("%s.%s", yyDollar[1].stringVal, yyDollar[3].stringVal)
+			yyVAL.stringVal = string(OnCommitDrop)
 		}
-	case 20:
+	case 77:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:674
+		{
+			yyVAL.stringVal = string(OnCommitDeleteRows)
+		}
+	case 78:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:678
+		{
+			yyVAL.stringVal = string(OnCommitPreserveRows)
+		}
+	case 79:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:682
+		{
+			yyVAL.stringVal = ""
+		}
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:145
+//line parser.y:688
 		{
-			yyVAL.column.Unique = true
+			yyVAL.t_header.Table = yyDollar[1].stringVal
+			yyVAL.t_header.key = TableName{Table: yylex.(*lexer).nameKey(yyDollar[1].pos, yyDollar[1].stringVal)}
 		}
-	case 21:
+	case 81:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:693
+		{
+			l := yylex.(*lexer)
+			yyVAL.t_header.Schema = yyDollar[1].stringVal
+			yyVAL.t_header.Table = yyDollar[3].stringVal
+			yyVAL.t_header.key = TableName{l.nameKey(yyDollar[1].pos, yyDollar[1].stringVal), l.nameKey(yyDollar[3].pos, yyDollar[3].stringVal)}
+		}
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:149
+//line parser.y:702
 		{
-			yyVAL.column.PrimaryKey = true
+			yyVAL.t_body = tableBody{}
+			yyVAL.t_body.addColumn(yyDollar[1].column)
 		}
-	case 22:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:153
+	case 83:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:707
 		{
-			yyVAL.column.NotNull = true
+			yyVAL.t_body.addColumn(yyDollar[3].column)
 		}
-	case 23:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:157
+	case 84:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:711
 		{
+			yyVAL.t_body = tableBody{}
+			yyVAL.t_body.addConstraint(yyDollar[1].pos, yyDollar[1].t_constraint)
 		}
-	case 24:
+	case 85:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:716
+		{
+			yyVAL.t_body.addConstraint(yyDollar[3].pos, yyDollar[3].t_constraint)
+		}
+	case 86:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:720
+		{
+			yyVAL.t_body = tableBody{likes: []*LikeClause{yyDollar[1].like}}
+		}
+	case 87:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:724
+		{
+			yyDollar[3].like.at = len(yyVAL.t_body.columns)
+			yyVAL.t_body.likes = append(yyVAL.t_body.likes, yyDollar[3].like)
+		}
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:160
+//line parser.y:731
+		{
+			yyVAL.like = &LikeClause{Source: TableName{yyDollar[2].t_header.Schema, yyDollar[2].t_header.Table}, sourceKey: yyDollar[2].t_header.key}
+		}
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:735
+		{
+			yylex.(*lexer).setLikeOption(yyVAL.like, yyDollar[3].pos, true, yyDollar[3].stringVal)
+		}
+	case 90:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:739
+		{
+			yylex.(*lexer).setLikeOption(yyVAL.like, yyDollar[3].pos, false, yyDollar[3].stringVal)
+		}
+	case 91:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:745
 		{
-			yyVAL.column.PrimaryKey = true
+			if yyDollar[3].column.constraintName != "" {
+				yylex.(*lexer).errorAt(yyDollar[3].column.constraintPos, "CONSTRAINT %s is not followed by a constraint", yyDollar[3].column.constraintName)
+			}
+			yyVAL.column = yyDollar[3].column
+			yyVAL.column.pos = yyDollar[1].pos
+			yyVAL.column.Name = yyDollar[1].stringVal
+			yyVAL.column.DataType = yyDollar[2].dataType
 		}
-	case 25:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:164
+//line parser.y:755
 		{
-			yyVAL.column.PrimaryKey = true
+			yyVAL.column.pos = yyDollar[1].pos
+			yyVAL.column.Name = yyDollar[1].stringVal
+			yyVAL.column.DataType = yyDollar[2].dataType
 		}
-	case 26:
+	case 93:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:761
+		{
+			if yyDollar[4].column.constraintName != "" {
+				yylex.(*lexer).errorAt(yyDollar[4].column.constraintPos, "CONSTRAINT %s is not followed by a constraint", yyDollar[4].column.constraintName)
+			}
+			yyVAL.column = yyDollar[4].column
+			yyVAL.column.pos = yyDollar[1].pos
+			yyVAL.column.Name = yyDollar[1].stringVal
+			yyVAL.column.DataType = yyDollar[2].dataType
+			yyVAL.column.Options = yyDollar[3].genericOptions
+		}
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:168
+//line parser.y:772
 		{
-			yyVAL.column.NotNull = true
+			yyVAL.column = columnObj{pos: yyDollar[1].pos, Name: yyDollar[1].stringVal, DataType: yyDollar[2].dataType, Options: yyDollar[3].genericOptions}
 		}
-	case 28:
+	case 96:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:779
+		{
+			yyVAL.genericOptions = nil
+		}
+	case 97:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:785
+		{
+			yyVAL.genericOptions = yyDollar[3].genericOptions
+		}
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:174
+//line parser.y:791
 		{
+			yyVAL.genericOptions = GenericOptions{yyDollar[1].stringVal: yyDollar[2].stringVal}
 		}
-	case 32:
+	case 99:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:183
+//line parser.y:795
 		{
-			yyVAL.t_constraint.Uniques = append(yyVAL.t_constraint.Uniques, yyDollar[3].stringsVal)
+			yylex.(*lexer).addGenericOption(yyVAL.genericOptions, yyDollar[3].pos, yyDollar[3].stringVal, yyDollar[4].stringVal)
 		}
-	case 33:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:189
+//line parser.y:802
 		{
-			yyVAL.stringsVal = []string{yyDollar[1].stringVal}
+			yyVAL.stringVal = strings.ToLower(yyDollar[1].stringVal)
 		}
-	case 34:
+	case 102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:807
+		{
+			yyVAL.stringVal = strings.ToLower(yyDollar[1].stringVal)
+		}
+	case 103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:811
+		{
+			yyVAL.stringVal = strings.ToLower(yyDollar[1].stringVal)
+		}
+	case 106:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:820
+		{
+			yyVAL.dataType = yyDollar[1].dataType
+			yyVAL.dataType.ArrayDims = yyDollar[2].intsVal
+		}
+	case 107:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:825
+		{
+			yyVAL.dataType = yyDollar[1].dataType
+			yyVAL.dataType.ArrayDims = []int{-1}
+		}
+	case 108:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:830
+		{
+			yyVAL.dataType = yyDollar[1].dataType
+			yyVAL.dataType.ArrayDims = []int{arrayBound(yyDollar[4].stringVal)}
+		}
+	case 110:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:838
+		{
+			yyVAL.dataType = yyDollar[1].dataType
+			yyVAL.dataType.Modifiers = yyDollar[3].stringsVal
+		}
+	case 112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:846
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal, quoted: yylex.(*lexer).quoted(yyDollar[1].pos)}
+		}
+	case 113:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:850
+		{
+			yyVAL.dataType = &DataType{Schema: yyDollar[1].stringVal, Name: yyDollar[3].stringVal, quoted: yylex.(*lexer).quoted(yyDollar[3].pos)}
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:856
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal + " " + yyDollar[2].stringVal}
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:860
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal}
+		}
+	case 116:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:864
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal, Modifiers: []string{yyDollar[3].stringVal}}
+		}
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:868
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal}
+		}
+	case 118:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:872
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal, Modifiers: []string{yyDollar[3].stringVal}}
+		}
+	case 119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:876
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal + yyDollar[2].stringVal}
+		}
+	case 120:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:880
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal + yyDollar[5].stringVal, Modifiers: []string{yyDollar[3].stringVal}}
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:884
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal}
+		}
+	case 122:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:888
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal, Modifiers: []string{yyDollar[3].stringVal}}
+		}
+	case 123:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:892
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal, IntervalFields: yyDollar[2].stringVal}
+		}
+	case 124:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:896
+		{
+			yyVAL.dataType = &DataType{Name: yyDollar[1].stringVal, IntervalFields: yyDollar[2].stringVal, Modifiers: []string{yyDollar[4].stringVal}}
+		}
+	case 126:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:903
+		{
+			yyVAL.stringVal = yyDollar[1].stringVal + " " + yyDollar[2].stringVal
+		}
+	case 130:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:912
+		{
+			yyVAL.stringVal = yyDollar[1].stringVal + " " + yyDollar[2].stringVal
+		}
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:916
+		{
+			yyVAL.stringVal = yyDollar[1].stringVal + " " + yyDollar[2].stringVal
+		}
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:923
+		{
+			yyVAL.stringVal = yyDollar[1].stringVal + " " + yyDollar[2].stringVal
+		}
+	case 136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:933
+		{
+			yyVAL.stringVal = " " + yyDollar[1].stringVal + " " + yyDollar[2].stringVal + " " + yyDollar[3].stringVal
+		}
+	case 137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:937
+		{
+			yyVAL.stringVal = " " + yyDollar[1].stringVal + " " + yyDollar[2].stringVal + " " + yyDollar[3].stringVal
+		}
+	case 138:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:941
+		{
+			yyVAL.stringVal = ""
+		}
+	case 140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:948
+		{
+			yyVAL.stringVal = yyDollar[1].stringVal + " " + yyDollar[2].stringVal + " " + yyDollar[3].stringVal
+		}
+	case 147:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:962
+		{
+			yyVAL.intsVal = []int{-1}
+		}
+	case 148:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:966
+		{
+			yyVAL.intsVal = []int{arrayBound(yyDollar[2].stringVal)}
+		}
+	case 149:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:970
+		{
+			yyVAL.intsVal = append(yyDollar[1].intsVal, -1)
+		}
+	case 150:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:974
+		{
+			yyVAL.intsVal = append(yyDollar[1].intsVal, arrayBound(yyDollar[3].stringVal))
+		}
+	case 151:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:980
+		{
+			yyVAL.stringsVal = []string{yyDollar[1].stringVal}
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:984
+		{
+			yyVAL.stringsVal = append(yyDollar[1].stringsVal, yyDollar[3].stringVal)
+		}
+	case 153:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:990
+		{
+			yyVAL.column.Uniques = []*Unique{yyDollar[1].unique}
+			yyVAL.column.attrs = &constraintAttributes{target: &yyDollar[1].unique.Deferrability}
+		}
+	case 154:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:995
+		{
+			yyVAL.column.PrimaryKey = &PrimaryKey{IndexParameters: yyDollar[2].indexParams}
+			yyVAL.column.attrs = &constraintAttributes{target: &yyVAL.column.PrimaryKey.Deferrability}
+		}
+	case 155:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1000
+		{
+			yyVAL.column.NotNull = &NotNull{}
+			yyVAL.column.notNullPos = yyDollar[1].pos
+		}
+	case 156:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1005
+		{
+			yyVAL.column.Null = true
+			yyVAL.column.nullPos = yyDollar[1].pos
+		}
+	case 157:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1010
+		{
+			yyVAL.column.Default = yylex.(*lexer).source(yyDollar[2].expr)
+			yyVAL.column.DefaultExpr = yyDollar[2].expr
+			yyVAL.column.defaultPos = yyDollar[1].pos
+		}
+	case 158:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1016
+		{
+			yyVAL.column.constraintName = yyDollar[2].stringVal
+			yyVAL.column.constraintPos = yyDollar[1].pos
+		}
+	case 159:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1021
+		{
+			yyDollar[2].unique.Name = yyVAL.column.nextConstraint()
+			yyVAL.column.Uniques = append(yyVAL.column.Uniques, yyDollar[2].unique)
+			yyVAL.column.attrs = &constraintAttributes{target: &yyDollar[2].unique.Deferrability}
+		}
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1027
+		{
+			if yyVAL.column.PrimaryKey != nil {
+				yyVAL.column.repeat(yyDollar[2].pos, "PRIMARY KEY")
+			}
+			yyVAL.column.PrimaryKey = &PrimaryKey{Name: yyVAL.column.nextConstraint(), IndexParameters: yyDollar[3].indexParams}
+			yyVAL.column.attrs = &constraintAttributes{target: &yyVAL.column.PrimaryKey.Deferrability}
+		}
+	case 161:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1035
+		{
+			if yyVAL.column.NotNull != nil {
+				yyVAL.column.repeat(yyDollar[2].pos, "NOT NULL")
+			}
+			yyVAL.column.NotNull = &NotNull{Name: yyVAL.column.nextConstraint()}
+			yyVAL.column.notNullPos = yyDollar[2].pos
+		}
+	case 162:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1043
+		{
+			yyVAL.column.nextConstraint()
+			if yyVAL.column.Null {
+				yyVAL.column.repeat(yyDollar[2].pos, "NULL")
+			}
+			yyVAL.column.Null = true
+			yyVAL.column.nullPos = yyDollar[2].pos
+		}
+	case 163:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1052
+		{
+			yyVAL.column.nextConstraint()
+			if yyVAL.column.DefaultExpr != nil {
+				yyVAL.column.repeat(yyDollar[2].pos, "DEFAULT")
+			}
+			yyVAL.column.Default = yylex.(*lexer).source(yyDollar[3].expr)
+			yyVAL.column.DefaultExpr = yyDollar[3].expr
+			yyVAL.column.defaultPos = yyDollar[2].pos
+		}
+	case 164:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1062
+		{
+			if yyVAL.column.constraintName != "" {
+				yylex.(*lexer).errorAt(yyVAL.column.constraintPos, "CONSTRAINT %s is not followed by a constraint", yyVAL.column.constraintName)
+			}
+			yyVAL.column.constraintName = yyDollar[3].stringVal
+			yyVAL.column.constraintPos = yyDollar[2].pos
+			yyVAL.column.attrs = nil
+		}
+	case 165:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1071
+		{
+			yyVAL.column.Checks = []*Check{yyDollar[1].check}
+		}
+	case 166:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1075
+		{
+			yyDollar[2].check.Name = yyVAL.column.nextConstraint()
+			yyVAL.column.Checks = append(yyVAL.column.Checks, yyDollar[2].check)
+		}
+	case 167:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1080
+		{
+			yyVAL.column.ForeignKeys = []*ForeignKey{yyDollar[1].foreignKey}
+			yyVAL.column.attrs = &constraintAttributes{target: &yyDollar[1].foreignKey.Deferrability}
+		}
+	case 168:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1085
+		{
+			yyDollar[2].foreignKey.Name = yyVAL.column.nextConstraint()
+			yyVAL.column.ForeignKeys = append(yyVAL.column.ForeignKeys, yyDollar[2].foreignKey)
+			yyVAL.column.attrs = &constraintAttributes{target: &yyDollar[2].foreignKey.Deferrability}
+		}
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1091
+		{
+			yyVAL.column.CollationSchema = yyDollar[2].qualified.Schema
+			yyVAL.column.Collation = yyDollar[2].qualified.Name
+		}
+	case 170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1096
+		{
+			// COLLATE is not a constraint and can not be named
+			if name := yyVAL.column.nextConstraint(); name != "" {
+				yylex.(*lexer).errorAt(yyVAL.column.constraintPos, "CONSTRAINT %s is not followed by a constraint", name)
+			}
+			yyVAL.column.CollationSchema = yyDollar[3].qualified.Schema
+			yyVAL.column.Collation = yyDollar[3].qualified.Name
+		}
+	case 172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1106
+		{
+			yyVAL.column.nextConstraint()
+			if yyDollar[2].column.Identity != nil {
+				yyVAL.column.Identity, yyVAL.column.identityPos = yyDollar[2].column.Identity, yyDollar[2].column.identityPos
+			} else {
+				yyVAL.column.Generated, yyVAL.column.GeneratedExpr, yyVAL.column.GeneratedStored = yyDollar[2].column.Generated, yyDollar[2].column.GeneratedExpr, yyDollar[2].column.GeneratedStored
+				yyVAL.column.generatedPos = yyDollar[2].column.generatedPos
+			}
+		}
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1116
+		{
+			// constraint attributes apply to the constraint before them
+			if yyVAL.column.attrs == nil {
+				yylex.(*lexer).errorAt(yyDollar[2].attribute.pos, "misplaced %s clause", yyDollar[2].attribute.clause)
+			} else if err := yyVAL.column.attrs.add(yyDollar[2].attribute); err != nil {
+				yylex.(*lexer).errorAt(yyDollar[2].attribute.pos, "%s", err)
+			}
+		}
+	case 174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1126
+		{
+		}
+	case 175:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1130
+		{
+			yyVAL.unique = &Unique{NullsNotDistinct: yyDollar[2].boolVal, IndexParameters: yyDollar[3].indexParams}
+		}
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1136
+		{
+			yyVAL.boolVal = false
+		}
+	case 177:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1140
+		{
+			yyVAL.boolVal = true
+		}
+	case 178:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1144
+		{
+			yyVAL.boolVal = false
+		}
+	case 179:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1150
+		{
+			yyVAL.indexParams = IndexParameters{Include: yyDollar[1].stringsVal, With: yyDollar[2].storageParams, Tablespace: yyDollar[3].stringVal}
+		}
+	case 180:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1156
+		{
+			yyVAL.stringVal = yyDollar[4].stringVal
+		}
+	case 181:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1160
+		{
+			yyVAL.stringVal = ""
+		}
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1166
+		{
+			yyVAL.qualified = qualifiedName{Name: yyDollar[1].stringVal}
+		}
+	case 183:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1170
+		{
+			yyVAL.qualified = qualifiedName{Schema: yyDollar[1].stringVal, Name: yyDollar[3].stringVal}
+		}
+	case 184:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1176
+		{
+			yyVAL.column = columnObj{Identity: &Identity{Always: yyDollar[2].boolVal, Sequence: *yyDollar[5].seqOptions}, identityPos: yyDollar[1].pos}
+		}
+	case 185:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1180
+		{
+			if !yyDollar[2].boolVal {
+				yylex.(*lexer).errorAt(yyDollar[1].pos, "for a generated column, GENERATED ALWAYS must be specified")
+			}
+			yyVAL.column = columnObj{Generated: yylex.(*lexer).source(yyDollar[5].expr), GeneratedExpr: yyDollar[5].expr, GeneratedStored: yyDollar[7].boolVal, generatedPos: yyDollar[1].pos}
+		}
+	case 186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1189
+		{
+			yyVAL.boolVal = true
+		}
+	case 187:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1193
+		{
+			yyVAL.boolVal = false
+		}
+	case 188:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1199
+		{
+			yyVAL.boolVal = true
+		}
+	case 189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1203
+		{
+			yyVAL.boolVal = false
+		}
+	case 190:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1207
+		{
+			yyVAL.boolVal = false
+		}
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1213
+		{
+			yyVAL.seqOptions = yyDollar[2].seqOptions
+		}
+	case 192:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1217
+		{
+			yyVAL.seqOptions = &SequenceOptions{}
+		}
+	case 193:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1223
+		{
+			yyVAL.seqOptions = &SequenceOptions{}
+		}
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1227
+		{
+			yyVAL.seqOptions.Type = yyDollar[3].dataType
+		}
+	case 195:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1231
+		{
+			yyVAL.seqOptions.Start = yylex.(*lexer).sequenceValue(yyDollar[4].pos, yyDollar[4].stringVal)
+		}
+	case 196:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1235
+		{
+			yyVAL.seqOptions.Increment = yylex.(*lexer).sequenceValue(yyDollar[4].pos, yyDollar[4].stringVal)
+		}
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1239
+		{
+			yyVAL.seqOptions.MinValue = yylex.(*lexer).sequenceValue(yyDollar[3].pos, yyDollar[3].stringVal)
+		}
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1243
+		{
+			yyVAL.seqOptions.MinValue = nil
+		}
+	case 199:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1247
+		{
+			yyVAL.seqOptions.MaxValue = yylex.(*lexer).sequenceValue(yyDollar[3].pos, yyDollar[3].stringVal)
+		}
+	case 200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1251
+		{
+			yyVAL.seqOptions.MaxValue = nil
+		}
+	case 201:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1255
+		{
+			yyVAL.seqOptions.Cache = yylex.(*lexer).sequenceValue(yyDollar[3].pos, yyDollar[3].stringVal)
+		}
+	case 202:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1259
+		{
+			yyVAL.seqOptions.Cycle = true
+		}
+	case 203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1263
+		{
+			yyVAL.seqOptions.Cycle = false
+		}
+	case 204:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1267
+		{
+			yyVAL.seqOptions.Name = yyDollar[4].stringVal
+		}
+	case 205:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1271
+		{
+			yyVAL.seqOptions.Name = yyDollar[4].stringVal + "." + yyDollar[6].stringVal
+		}
+	case 207:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1278
+		{
+			yyVAL.stringVal = ""
+		}
+	case 209:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1285
+		{
+			yyVAL.stringVal = ""
+		}
+	case 211:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1292
+		{
+			yyVAL.stringVal = "-" + yyDollar[2].stringVal
+		}
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1299
+		{
+			yyVAL.expr = &TypeCast{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Type: yyDollar[3].dataType}
+		}
+	case 214:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1303
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 215:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1307
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 216:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1311
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 217:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1315
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 218:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1319
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 219:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1323
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 220:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1327
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1331
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 222:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1335
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 223:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1339
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1343
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1347
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1351
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 227:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1355
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 228:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1359
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1363
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1367
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 231:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1371
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 232:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1375
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1379
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1383
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal+" "+yyDollar[3].stringVal, yyDollar[1].expr, yyDollar[4].expr)
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1387
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 236:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1391
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal+" "+yyDollar[3].stringVal, yyDollar[1].expr, yyDollar[4].expr)
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1395
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Test: IsNull}
+		}
+	case 238:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1399
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Not: true, Test: IsNull}
+		}
+	case 239:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1403
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Test: IsNull}
+		}
+	case 240:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1407
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Not: true, Test: IsNull}
+		}
+	case 241:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1411
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Test: IsTrue}
+		}
+	case 242:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1415
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Not: true, Test: IsTrue}
+		}
+	case 243:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1419
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Test: IsFalse}
+		}
+	case 244:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1423
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Not: true, Test: IsFalse}
+		}
+	case 245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1427
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Test: IsUnknown}
+		}
+	case 246:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1431
+		{
+			yyVAL.expr = &IsExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Not: true, Test: IsUnknown}
+		}
+	case 247:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1435
+		{
+			yyVAL.expr = newBinaryExpr("IS DISTINCT FROM", yyDollar[1].expr, yyDollar[5].expr)
+		}
+	case 248:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1439
+		{
+			yyVAL.expr = newBinaryExpr("IS NOT DISTINCT FROM", yyDollar[1].expr, yyDollar[6].expr)
+		}
+	case 249:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1443
+		{
+			yyVAL.expr = &BetweenExpr{span: span{yyDollar[1].expr.Pos(), yyDollar[5].expr.End()}, Arg: yyDollar[1].expr, Low: yyDollar[3].expr, High: yyDollar[5].expr}
+		}
+	case 250:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1447
+		{
+			yyVAL.expr = &BetweenExpr{span: span{yyDollar[1].expr.Pos(), yyDollar[6].expr.End()}, Arg: yyDollar[1].expr, Not: true, Low: yyDollar[4].expr, High: yyDollar[6].expr}
+		}
+	case 251:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1451
+		{
+			yyVAL.expr = &InExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, List: yyDollar[4].exprs}
+		}
+	case 252:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1455
+		{
+			yyVAL.expr = &InExpr{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Not: true, List: yyDollar[5].exprs}
+		}
+	case 254:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1463
+		{
+			yyVAL.expr = &TypeCast{span: span{yyDollar[1].expr.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[1].expr, Type: yyDollar[3].dataType}
+		}
+	case 255:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1467
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 256:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1471
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 257:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1475
+		{
+			yyVAL.expr = newUnaryExpr(yyDollar[1].pos, yyDollar[1].stringVal, yyDollar[2].expr)
+		}
+	case 258:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1479
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 259:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1483
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 260:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1487
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1491
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 262:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1495
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 263:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1499
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 264:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1503
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 265:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1507
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 266:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1511
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 267:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1515
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 268:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1519
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 269:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1523
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 270:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1527
+		{
+			yyVAL.expr = newBinaryExpr(yyDollar[2].stringVal, yyDollar[1].expr, yyDollar[3].expr)
+		}
+	case 271:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1531
+		{
+			yyVAL.expr = newBinaryExpr("IS DISTINCT FROM", yyDollar[1].expr, yyDollar[5].expr)
+		}
+	case 272:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1535
+		{
+			yyVAL.expr = newBinaryExpr("IS NOT DISTINCT FROM", yyDollar[1].expr, yyDollar[6].expr)
+		}
+	case 276:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1544
+		{
+			yyVAL.expr = &ParenExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, X: yyDollar[2].expr}
+		}
+	case 277:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1548
+		{
+			yyVAL.expr = &TypeCast{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[3].expr, Type: yyDollar[5].dataType}
+		}
+	case 279:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1553
+		{
+			array := yyDollar[2].expr.(*ArrayExpr)
+			array.pos = yyDollar[1].pos
+			array.Nested = false
+			yyVAL.expr = array
+		}
+	case 280:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1560
+		{
+			yyVAL.expr = &RowExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}}
+		}
+	case 281:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1564
+		{
+			yyVAL.expr = &RowExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Args: yyDollar[3].exprs}
+		}
+	case 282:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1568
+		{
+			yyVAL.expr = &RowExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Args: append([]Expr{yyDollar[2].expr}, yyDollar[4].exprs...), Implicit: true}
+		}
+	case 283:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1574
+		{
+			yyVAL.expr = &Literal{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralString, Value: yyDollar[1].stringVal}
+		}
+	case 284:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1578
+		{
+			yyVAL.expr = &Literal{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralNumber, Value: yyDollar[1].stringVal}
+		}
+	case 285:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1582
+		{
+			yyVAL.expr = &Literal{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralBool, Value: yyDollar[1].stringVal}
+		}
+	case 286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1586
+		{
+			yyVAL.expr = &Literal{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralBool, Value: yyDollar[1].stringVal}
+		}
+	case 287:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1590
+		{
+			yyVAL.expr = &Literal{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralNull, Value: yyDollar[1].stringVal}
+		}
+	case 288:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1594
+		{
+			end := ruleEnd(yylex, yyrcvr.char)
+			arg := &Literal{span: span{yyDollar[2].pos, end}, Kind: LiteralString, Value: yyDollar[2].stringVal}
+			yyVAL.expr = &TypeCast{span: span{yyDollar[1].pos, end}, Arg: arg, Type: &DataType{Name: yyDollar[1].stringVal, quoted: yylex.(*lexer).quoted(yyDollar[1].pos)}}
+		}
+	case 289:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1600
+		{
+			end := ruleEnd(yylex, yyrcvr.char)
+			arg := &Literal{span: span{yyDollar[2].pos, end}, Kind: LiteralString, Value: yyDollar[2].stringVal}
+			yyVAL.expr = &TypeCast{span: span{yyDollar[1].pos, end}, Arg: arg, Type: yyDollar[1].dataType}
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1608
+		{
+			yyVAL.expr = &FuncCall{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Name: yyDollar[1].stringVal}
+		}
+	case 291:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1612
+		{
+			yyVAL.expr = &FuncCall{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Name: yyDollar[1].stringVal, Args: yyDollar[3].exprs}
+		}
+	case 292:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1616
+		{
+			yyVAL.expr = &FuncCall{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Schema: yyDollar[1].stringVal, Name: yyDollar[3].stringVal}
+		}
+	case 293:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1620
+		{
+			yyVAL.expr = &FuncCall{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Schema: yyDollar[1].stringVal, Name: yyDollar[3].stringVal, Args: yyDollar[5].exprs}
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1626
+		{
+			if name := strings.ToUpper(yyDollar[1].stringVal); sqlValueFuncs[name] && !yylex.(*lexer).quoted(yyDollar[1].pos) {
+				yyVAL.expr = &SQLValueFunc{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Name: name}
+			} else {
+				yyVAL.expr = &ColumnRef{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Names: []string{yyDollar[1].stringVal}}
+			}
+		}
+	case 295:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1634
+		{
+			yyVAL.expr = &ColumnRef{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Names: []string{yyDollar[1].stringVal, yyDollar[3].stringVal}}
+		}
+	case 296:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1640
+		{
+			yyVAL.expr = &CaseExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Whens: yyDollar[2].caseWhens}
+		}
+	case 297:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1644
+		{
+			yyVAL.expr = &CaseExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Whens: yyDollar[2].caseWhens, Else: yyDollar[4].expr}
+		}
+	case 298:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1648
+		{
+			yyVAL.expr = &CaseExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[2].expr, Whens: yyDollar[3].caseWhens}
+		}
+	case 299:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1652
+		{
+			yyVAL.expr = &CaseExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Arg: yyDollar[2].expr, Whens: yyDollar[3].caseWhens, Else: yyDollar[5].expr}
+		}
+	case 300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1658
+		{
+			yyVAL.caseWhens = []*CaseWhen{yyDollar[1].caseWhen}
+		}
+	case 301:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1662
+		{
+			yyVAL.caseWhens = append(yyDollar[1].caseWhens, yyDollar[2].caseWhen)
+		}
+	case 302:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1668
+		{
+			yyVAL.caseWhen = &CaseWhen{Cond: yyDollar[2].expr, Result: yyDollar[4].expr}
+		}
+	case 303:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1674
+		{
+			yyVAL.expr = &ArrayExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Nested: true}
+		}
+	case 304:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1678
+		{
+			yyVAL.expr = &ArrayExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Elems: yyDollar[2].exprs, Nested: true}
+		}
+	case 305:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1682
+		{
+			yyVAL.expr = &ArrayExpr{span: span{yyDollar[1].pos, ruleEnd(yylex, yyrcvr.char)}, Elems: yyDollar[2].exprs, Nested: true}
+		}
+	case 306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1688
+		{
+			yyVAL.exprs = []Expr{yyDollar[1].expr}
+		}
+	case 307:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1692
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1698
+		{
+			yyVAL.exprs = []Expr{yyDollar[1].expr}
+		}
+	case 309:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1702
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 310:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1708
+		{
+			if pos, err := yyVAL.t_constraint.setAttributes(yyDollar[2].attributes); err != nil {
+				yylex.(*lexer).errorAt(pos, "%s", err)
+			}
+		}
+	case 311:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1714
+		{
+			yyVAL.t_constraint = yyDollar[3].t_constraint
+			yyVAL.t_constraint.setName(yyDollar[2].stringVal)
+			if pos, err := yyVAL.t_constraint.setAttributes(yyDollar[4].attributes); err != nil {
+				yylex.(*lexer).errorAt(pos, "%s", err)
+			}
+		}
+	case 312:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1724
+		{
+			yyVAL.t_constraint = TableConstraint{Uniques: []*Unique{{Columns: yyDollar[4].stringsVal, NullsNotDistinct: yyDollar[2].boolVal, IndexParameters: yyDollar[6].indexParams}}}
+		}
+	case 313:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1728
+		{
+			yyVAL.t_constraint = TableConstraint{PrimaryKey: &PrimaryKey{Columns: yyDollar[3].stringsVal, IndexParameters: yyDollar[5].indexParams}}
+		}
+	case 314:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1732
+		{
+			fk := yyDollar[6].foreignKey
+			fk.Columns = yyDollar[4].stringsVal
+			yyVAL.t_constraint = TableConstraint{ForeignKeys: []*ForeignKey{fk}}
+		}
+	case 315:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1738
+		{
+			yyVAL.t_constraint = TableConstraint{Checks: []*Check{yyDollar[1].check}}
+		}
+	case 316:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1742
+		{
+			yyVAL.t_constraint = TableConstraint{Exclusions: []*Exclusion{yyDollar[1].exclusion}}
+		}
+	case 317:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1748
+		{
+			yyVAL.exclusion = &Exclusion{
+				Method:          yyDollar[2].stringVal,
+				Elements:        yyDollar[4].exclusionElems,
+				IndexParameters: yyDollar[6].indexParams,
+				Where:           yyDollar[7].expr,
+			}
+			if yyDollar[7].expr != nil {
+				yyVAL.exclusion.WhereSource = yylex.(*lexer).source(yyDollar[7].expr)
+			}
+		}
+	case 318:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1762
+		{
+			yyVAL.stringVal = yyDollar[2].stringVal
+		}
+	case 319:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1766
+		{
+			yyVAL.stringVal = ""
+		}
+	case 320:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1772
+		{
+			yyVAL.exclusionElems = []*ExclusionElem{yyDollar[1].exclusionElem}
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1776
+		{
+			yyVAL.exclusionElems = append(yyDollar[1].exclusionElems, yyDollar[3].exclusionElem)
+		}
+	case 322:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1782
+		{
+			yyVAL.exclusionElem = yyDollar[1].exclusionElem
+			yyVAL.exclusionElem.Operator = yyDollar[3].stringVal
+		}
+	case 323:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1789
+		{
+			yyVAL.exclusionElem = yyDollar[1].exclusionElem
+			yyVAL.exclusionElem.OpClass = yyDollar[2].stringVal
+			yyVAL.exclusionElem.Order = yyDollar[3].stringVal
+			yyVAL.exclusionElem.NullsOrder = yyDollar[4].stringVal
+		}
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1798
+		{
+			yyVAL.exclusionElem = &ExclusionElem{Column: yyDollar[1].stringVal}
+		}
+	case 325:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1802
+		{
+			yyVAL.exclusionElem = &ExclusionElem{Expr: yyDollar[1].expr, Source: yylex.(*lexer).source(yyDollar[1].expr)}
+		}
+	case 326:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1806
+		{
+			yyVAL.exclusionElem = &ExclusionElem{Expr: yyDollar[2].expr, Source: yylex.(*lexer).source(yyDollar[2].expr)}
+		}
+	case 328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1813
+		{
+			yyVAL.stringVal = yyDollar[1].stringVal + "." + yyDollar[3].stringVal
+		}
+	case 329:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1817
+		{
+			yyVAL.stringVal = ""
+		}
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1823
+		{
+			yyVAL.stringVal = "ASC"
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1827
+		{
+			yyVAL.stringVal = "DESC"
+		}
+	case 332:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1831
+		{
+			yyVAL.stringVal = ""
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1837
+		{
+			yyVAL.stringVal = "FIRST"
+		}
+	case 334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1841
+		{
+			yyVAL.stringVal = "LAST"
+		}
+	case 335:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1845
+		{
+			yyVAL.stringVal = ""
+		}
+	case 349:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1866
+		{
+			yyVAL.stringsVal = yyDollar[3].stringsVal
+		}
+	case 350:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1870
+		{
+			yyVAL.stringsVal = nil
+		}
+	case 351:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1876
+		{
+			yyVAL.storageParams = yyDollar[2].storageParams
+		}
+	case 352:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1880
+		{
+			yyVAL.storageParams = nil
+		}
+	case 353:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1886
+		{
+			yyVAL.storageParams = yyDollar[2].storageParams
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1892
+		{
+			yyVAL.storageParams = StorageParameters{yyDollar[1].stringsVal[0]: yyDollar[1].stringsVal[1]}
+		}
+	case 355:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1896
+		{
+			yyVAL.storageParams[yyDollar[3].stringsVal[0]] = yyDollar[3].stringsVal[1]
+		}
+	case 356:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1903
+		{
+			yyVAL.stringsVal = []string{yyDollar[1].stringVal, yyDollar[3].stringVal}
+		}
+	case 357:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1907
+		{
+			yyVAL.stringsVal = []string{yyDollar[1].stringVal, ""}
+		}
+	case 358:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1913
+		{
+			yyVAL.stringVal = strings.ToLower(yyDollar[1].stringVal)
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1917
+		{
+			yyVAL.stringVal = strings.ToLower(yyDollar[1].stringVal + "." + yyDollar[3].stringVal)
+		}
+	case 361:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1924
+		{
+			yyVAL.stringVal = "-" + yyDollar[2].stringVal
+		}
+	case 366:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1934
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 367:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1938
+		{
+			yyVAL.expr = nil
+		}
+	case 368:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1944
+		{
+			yyVAL.check = &Check{Expr: yyDollar[3].expr, Source: yylex.(*lexer).source(yyDollar[3].expr), NoInherit: yyDollar[5].boolVal}
+		}
+	case 369:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1950
+		{
+			yyVAL.boolVal = true
+		}
+	case 370:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1954
+		{
+			yyVAL.boolVal = false
+		}
+	case 371:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1960
+		{
+			yyVAL.foreignKey = newForeignKey(yyDollar[2].t_header, yyDollar[3].stringsVal)
+			if yyDollar[4].stringVal != "" {
+				yyVAL.foreignKey.Match = MatchType(yyDollar[4].stringVal)
+			}
+			if yyDollar[5].stringsVal[0] != "" {
+				yyVAL.foreignKey.OnDelete = ReferentialAction(yyDollar[5].stringsVal[0])
+			}
+			if yyDollar[5].stringsVal[1] != "" {
+				yyVAL.foreignKey.OnUpdate = ReferentialAction(yyDollar[5].stringsVal[1])
+			}
+		}
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1975
+		{
+			yyVAL.stringsVal = yyDollar[2].stringsVal
+		}
+	case 373:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1979
+		{
+			yyVAL.stringsVal = nil
+		}
+	case 374:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1985
+		{
+			yyVAL.stringVal = string(MatchFull)
+		}
+	case 375:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1989
+		{
+			yyVAL.stringVal = string(MatchPartial)
+		}
+	case 376:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1993
+		{
+			yyVAL.stringVal = string(MatchSimple)
+		}
+	case 377:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1997
+		{
+			yyVAL.stringVal = ""
+		}
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2004
+		{
+			yyVAL.stringsVal = []string{yyDollar[1].stringVal, ""}
+		}
+	case 379:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2008
+		{
+			yyVAL.stringsVal = []string{"", yyDollar[1].stringVal}
+		}
+	case 380:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2012
+		{
+			yyVAL.stringsVal = []string{yyDollar[1].stringVal, yyDollar[2].stringVal}
+		}
+	case 381:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2016
+		{
+			yyVAL.stringsVal = []string{yyDollar[2].stringVal, yyDollar[1].stringVal}
+		}
+	case 382:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2020
+		{
+			yyVAL.stringsVal = []string{"", ""}
+		}
+	case 383:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2026
+		{
+			yyVAL.stringVal = yyDollar[3].stringVal
+		}
+	case 384:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2032
+		{
+			yyVAL.stringVal = yyDollar[3].stringVal
+		}
+	case 385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2038
+		{
+			yyVAL.stringVal = string(ActionNoAction)
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2042
+		{
+			yyVAL.stringVal = string(ActionRestrict)
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2046
+		{
+			yyVAL.stringVal = string(ActionCascade)
+		}
+	case 388:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2050
+		{
+			yyVAL.stringVal = string(ActionSetNull)
+		}
+	case 389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2054
+		{
+			yyVAL.stringVal = string(ActionSetDefault)
+		}
+	case 391:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2061
+		{
+			yyVAL.attributes = nil
+		}
+	case 392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2067
+		{
+			yyVAL.attributes = []constraintAttribute{yyDollar[1].attribute}
+		}
+	case 393:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2071
+		{
+			yyVAL.attributes = append(yyDollar[1].attributes, yyDollar[2].attribute)
+		}
+	case 394:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2077
+		{
+			yyVAL.attribute = constraintAttribute{"DEFERRABLE", yyDollar[1].pos}
+		}
+	case 395:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2081
+		{
+			yyVAL.attribute = constraintAttribute{"NOT DEFERRABLE", yyDollar[1].pos}
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2085
+		{
+			yyVAL.attribute = constraintAttribute{"INITIALLY DEFERRED", yyDollar[1].pos}
+		}
+	case 397:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2089
+		{
+			yyVAL.attribute = constraintAttribute{"INITIALLY IMMEDIATE", yyDollar[1].pos}
+		}
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2095
+		{
+			yyVAL.stringsVal = []string{yyDollar[1].stringVal}
+		}
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:193
+//line parser.y:2099
 		{
 			yyVAL.stringsVal = append(yyDollar[1].stringsVal, yyDollar[3].stringVal)
 		}
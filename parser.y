@@ -1,16 +1,52 @@
 %{
 package tableParser
 
+import "strings"
+
+// ruleEnd returns the end position of the rule being reduced,
+// char is the lookahead token of the parser, -1 if it has not been read.
+func ruleEnd(yylex yyLexer, char int) Pos {
+	return yylex.(*lexer).lastEnd(char >= 0)
+}
+
 %}
 
 %union{
 	stringVal string
 	stringsVal []string
+	intsVal []int
 	boolVal bool
 	column columnObj
 	t_constraint  TableConstraint
 	t_header tableHeader
 	t_body tableBody
+	dataType *DataType
+	pos Pos
+	expr Expr
+	exprs []Expr
+	caseWhen *CaseWhen
+	caseWhens []*CaseWhen
+	foreignKey *ForeignKey
+	check *Check
+	exclusion *Exclusion
+	exclusionElem *ExclusionElem
+	exclusionElems []*ExclusionElem
+	storageParams StorageParameters
+	seqOptions *SequenceOptions
+	unique *Unique
+	indexParams IndexParameters
+	t_options tableOptions
+	partitionSpec *PartitionSpec
+	partitionKey *PartitionKey
+	partitionKeys []*PartitionKey
+	partitionBound *PartitionBound
+	t_headers []tableHeader
+	like *LikeClause
+	typeAttributes []*TableColumn
+	genericOptions GenericOptions
+	qualified qualifiedName
+	attribute constraintAttribute
+	attributes []constraintAttribute
 }
 
 %token <stringVal> tokenError
@@ -22,13 +58,31 @@ package tableParser
        tokenPgValue
        tokenLeftParen
        tokenRightParen
+       tokenLeftBracket
+       tokenRightBracket
        tokenComma
        tokenSemicolon
        tokenDot
+       tokenTypeCast
+       tokenPlus
+       tokenMinus
+       tokenStar
+       tokenSlash
+       tokenPercent
+       tokenCaret
+       tokenLess
+       tokenGreater
+       tokenEquals
+       tokenLessEquals
+       tokenGreaterEquals
+       tokenNotEquals
+       tokenOp
+       tokenComment
+       tokenQuery
 
 %token tokenKeyword
 
-%token tokenCreate
+%token <stringVal> tokenCreate
        tokenTable
        tokenIF
        tokenNOT
@@ -38,14 +92,190 @@ package tableParser
        tokenUNIQUE
        tokenPRIMARY
        tokenKEY
+       tokenARRAY
+       tokenTRUE
+       tokenFALSE
+       tokenDOUBLE
+       tokenPRECISION
+       tokenCHARACTER
+       tokenCHAR
+       tokenVARYING
+       tokenNATIONAL
+       tokenNCHAR
+       tokenBIT
+       tokenTIMESTAMP
+       tokenTIME
+       tokenWITH
+       tokenWITHOUT
+       tokenZONE
+       tokenINTERVAL
+       tokenYEAR
+       tokenMONTH
+       tokenDAY
+       tokenHOUR
+       tokenMINUTE
+       tokenSECOND
+       tokenTO
+       tokenAND
+       tokenOR
+       tokenIS
+       tokenISNULL
+       tokenNOTNULL
+       tokenUNKNOWN
+       tokenDISTINCT
+       tokenFROM
+       tokenBETWEEN
+       tokenIN
+       tokenLIKE
+       tokenILIKE
+       tokenCASE
+       tokenWHEN
+       tokenTHEN
+       tokenELSE
+       tokenEND
+       tokenCAST
+       tokenAS
+       tokenROW
+       tokenFOREIGN
+       tokenREFERENCES
+       tokenMATCH
+       tokenFULL
+       tokenPARTIAL
+       tokenSIMPLE
+       tokenON
+       tokenDELETE
+       tokenUPDATE
+       tokenCASCADE
+       tokenRESTRICT
+       tokenSET
+       tokenNO
+       tokenACTION
+       tokenDEFERRABLE
+       tokenINITIALLY
+       tokenDEFERRED
+       tokenIMMEDIATE
+       tokenCHECK
+       tokenINHERIT
+       tokenCONSTRAINT
+       tokenEXCLUDE
+       tokenUSING
+       tokenINCLUDE
+       tokenWHERE
+       tokenASC
+       tokenDESC
+       tokenNULLS
+       tokenFIRST
+       tokenLAST
+       tokenGENERATED
+       tokenALWAYS
+       tokenBY
+       tokenIDENTITY
+       tokenSTORED
+       tokenVIRTUAL
+       tokenSTART
+       tokenINCREMENT
+       tokenMINVALUE
+       tokenMAXVALUE
+       tokenCACHE
+       tokenCYCLE
+       tokenSEQUENCE
+       tokenNAME
+       tokenCOLLATE
+       tokenINDEX
+       tokenTABLESPACE
+       tokenTEMP
+       tokenTEMPORARY
+       tokenUNLOGGED
+       tokenGLOBAL
+       tokenLOCAL
+       tokenCOMMIT
+       tokenPRESERVE
+       tokenROWS
+       tokenDROP
+       tokenPARTITION
+       tokenOF
+       tokenFOR
+       tokenVALUES
+       tokenOPTIONS
+       tokenINHERITS
+       tokenINCLUDING
+       tokenEXCLUDING
+       tokenOIDS
+       tokenTYPE
+       tokenSELECT
+       tokenINTO
+       tokenMATERIALIZED
+       tokenVIEW
+       tokenDATA
+       tokenSERVER
+
+/* NULLS after an index element begins the nulls order, an operator class named nulls must be quoted */
+%nonassoc tokenNULLS
+%nonassoc tokenEmptyOpclass
+%left tokenOR
+%left tokenAND
+%right tokenNOT
+%nonassoc tokenIS tokenISNULL tokenNOTNULL
+%nonassoc tokenLess tokenGreater tokenEquals tokenLessEquals tokenGreaterEquals tokenNotEquals
+%nonassoc tokenBETWEEN tokenIN tokenLIKE tokenILIKE
+%left tokenOp
+%left tokenPlus tokenMinus
+%left tokenStar tokenSlash tokenPercent
+%left tokenCaret
+%right tokenUnaryMinus
+%left tokenTypeCast
 
-%type <column> ddl_table_column ddl_column_constraint
+%type <column> ddl_table_column ddl_column_constraint ddl_generated
 %type <t_header> ddl_create_table_header ddl_tableName
 %type <t_body> ddl_create_table_body
-%type <t_constraint>  ddl_table_constraint
+%type <t_constraint> ddl_table_constraint ddl_table_constraint_elem
 
-%type <stringVal> ddl_symbol ddl_column_name ddl_data_type ddl_value
-%type <stringsVal> ddl_column_names
+%type <dataType> ddl_data_type ddl_simple_type ddl_type_name ddl_const_type
+%type <stringVal> ddl_symbol ddl_type_symbol ddl_column_name ddl_value
+%type <stringVal> ddl_character_type ddl_character_word ddl_bit_type ddl_datetime_word ddl_opt_timezone
+%type <stringVal> ddl_interval_fields ddl_interval_unit
+%type <stringVal> ddl_unreserved_keyword ddl_col_name_keyword
+%type <stringsVal> ddl_column_names ddl_type_modifiers ddl_opt_column_list ddl_key_actions
+%type <attributes> ddl_constraint_attributes ddl_opt_constraint_attributes
+%type <stringVal> ddl_key_match ddl_key_delete ddl_key_update ddl_key_action
+%type <attribute> ddl_constraint_attribute
+%type <foreignKey> ddl_references
+%type <check> ddl_check
+%type <exclusion> ddl_exclusion
+%type <exclusionElem> ddl_exclusion_elem ddl_index_elem ddl_index_elem_expr
+%type <exclusionElems> ddl_exclusion_elems
+%type <storageParams> ddl_opt_table_with ddl_opt_with_params ddl_reloptions ddl_reloption_list
+%type <stringVal> ddl_opt_index_method ddl_opt_opclass ddl_opt_asc_desc ddl_opt_nulls_order ddl_any_operator ddl_reloption_name ddl_reloption_value
+%type <stringsVal> ddl_opt_include ddl_reloption
+%type <expr> ddl_opt_where
+%type <boolVal> ddl_generated_when ddl_opt_generated_stored
+%type <seqOptions> ddl_opt_seq_options ddl_seq_options
+%type <qualified> ddl_collation
+%type <t_header> ddl_create_view_header ddl_create_foreign_header
+%type <stringVal> ddl_generic_option_name
+%type <genericOptions> ddl_opt_generic_options ddl_generic_options ddl_generic_option_list
+%type <unique> ddl_column_unique
+%type <indexParams> ddl_index_params
+%type <boolVal> ddl_opt_nulls_not_distinct
+%type <stringVal> ddl_opt_index_tablespace ddl_opt_tablespace ddl_opt_temp ddl_opt_on_commit ddl_opt_collate
+%type <t_options> ddl_create_table_options
+%type <partitionSpec> ddl_opt_partition_spec
+%type <partitionKey> ddl_partition_key
+%type <partitionKeys> ddl_partition_keys
+%type <partitionBound> ddl_partition_bound ddl_hash_bound
+%type <t_body> ddl_opt_typed_table_body ddl_typed_table_body ddl_opt_create_table_body
+%type <t_headers> ddl_opt_inherits ddl_table_names
+%type <like> ddl_table_like
+%type <column> ddl_typed_table_column ddl_type_attribute
+%type <typeAttributes> ddl_opt_type_attributes ddl_type_attributes
+%type <boolVal> ddl_opt_with_options ddl_opt_with_data
+%type <stringVal> ddl_signed_number ddl_opt_by ddl_opt_with
+%type <boolVal> ddl_opt_no_inherit
+%type <intsVal> ddl_array_bounds
+%type <expr> ddl_a_expr ddl_b_expr ddl_c_expr ddl_const ddl_func_call ddl_column_ref ddl_case_expr ddl_array_expr
+%type <exprs> ddl_exprs ddl_array_exprs
+%type <caseWhen> ddl_case_when
+%type <caseWhens> ddl_case_whens
 %type <boolVal> ddl_column_primary_key
 
 %%
@@ -55,148 +285,1914 @@ ddlmulti: ddlmulti tokenSemicolon ddl
 		| ddl
 
 ddl: ddl_create_table
+   | ddl_create_type
+   | ddl_select
    | /* Empty */
 
 ddl_create_table
-	: ddl_create_table_header tokenLeftParen ddl_create_table_body tokenRightParen
-	{
-		columns := []*TableColumn{}
-		constraint := $3.constraint
-		for _,obj := range $3.columns {
-			columns = append(columns,obj.Column())
-			if obj.PrimaryKey {
-				constraint.PrimaryKey = append(constraint.PrimaryKey,obj.Name)
-			}
-			if obj.Unique {
-				constraint.Uniques = append(constraint.Uniques,[]string{obj.Name})
-			}
-		}
-		ast := yylex.(*lexer).ast
-		yylex.(*lexer).ast = append(ast,&TableDefine{
-			Schema: $1.Schema,
-			Table: $1.Table,
-			Columns: columns,
-			Constraint: &constraint,
-		})
+	: ddl_create_table_header tokenLeftParen ddl_opt_create_table_body tokenRightParen ddl_opt_inherits ddl_create_table_options
+	{
+		l := yylex.(*lexer)
+		def := l.newTableDefine($<pos>1, $1, $3, $6)
+		def.setInherits($5)
+		l.ast = append(l.ast, def)
+	}
+	| ddl_create_table_header tokenPARTITION tokenOF ddl_tableName ddl_opt_typed_table_body ddl_partition_bound ddl_create_table_options
+	{
+		l := yylex.(*lexer)
+		def := l.newTableDefine($<pos>1, $1, $5, $7)
+		def.PartitionOf = $6
+		def.PartitionOf.ParentSchema = $4.Schema
+		def.PartitionOf.Parent = $4.Table
+		def.PartitionOf.parentKey = $4.key
+		l.ast = append(l.ast, def)
+	}
+	| ddl_create_table_header tokenOF ddl_tableName ddl_opt_typed_table_body ddl_create_table_options
+	{
+		l := yylex.(*lexer)
+		def := l.newTableDefine($<pos>1, $1, $4, $5)
+		def.OfType = &TableName{$3.Schema, $3.Table}
+		def.ofTypeKey = $3.key
+		l.ast = append(l.ast, def)
+	}
+	| ddl_create_table_header ddl_opt_column_list ddl_opt_table_with ddl_opt_on_commit ddl_opt_tablespace tokenAS tokenQuery ddl_opt_with_data
+	{
+		l := yylex.(*lexer)
+		options := tableOptions{with: $3, onCommit: OnCommitAction($4), onCommitPos: $<pos>4, tablespace: $5}
+		def := l.newTableDefine($<pos>1, $1, tableBody{}, options)
+		def.Query = &TableQuery{Kind: QueryCreateTableAs, Columns: $2, Text: $7, NoData: $8}
+		l.ast = append(l.ast, def)
+	}
+	| ddl_create_view_header ddl_opt_column_list ddl_opt_table_with ddl_opt_tablespace tokenAS tokenQuery ddl_opt_with_data
+	{
+		l := yylex.(*lexer)
+		def := l.newTableDefine($<pos>1, $1, tableBody{}, tableOptions{with: $3, tablespace: $4})
+		def.Query = &TableQuery{Kind: QueryMaterializedView, Columns: $2, Text: $6, NoData: $7}
+		l.ast = append(l.ast, def)
+	}
+	| ddl_create_foreign_header tokenLeftParen ddl_opt_create_table_body tokenRightParen ddl_opt_inherits tokenSERVER ddl_symbol ddl_opt_generic_options
+	{
+		l := yylex.(*lexer)
+		def := l.newTableDefine($<pos>1, $1, $3, tableOptions{})
+		def.setInherits($5)
+		l.setForeign(def, $7, $8)
+		l.ast = append(l.ast, def)
+	}
+	| ddl_create_foreign_header tokenPARTITION tokenOF ddl_tableName ddl_opt_typed_table_body ddl_partition_bound tokenSERVER ddl_symbol ddl_opt_generic_options
+	{
+		l := yylex.(*lexer)
+		def := l.newTableDefine($<pos>1, $1, $5, tableOptions{})
+		def.PartitionOf = $6
+		def.PartitionOf.ParentSchema = $4.Schema
+		def.PartitionOf.Parent = $4.Table
+		def.PartitionOf.parentKey = $4.key
+		l.setForeign(def, $8, $9)
+		l.ast = append(l.ast, def)
+	}
+
+ddl_create_foreign_header
+	: tokenCreate tokenFOREIGN tokenTable ddl_tableName
+	{
+		$$ = $4
+	}
+	| tokenCreate tokenFOREIGN tokenTable tokenIF tokenNOT tokenEXISTS ddl_tableName
+	{
+		$$ = $7
+		$$.IfNotExists = true
+	}
+
+ddl_create_view_header
+	: tokenCreate tokenMATERIALIZED tokenVIEW ddl_tableName
+	{
+		$$ = $4
+	}
+	| tokenCreate tokenMATERIALIZED tokenVIEW tokenIF tokenNOT tokenEXISTS ddl_tableName
+	{
+		$$ = $7
+		$$.IfNotExists = true
+	}
+
+/* the lexer reads the query after AS as one token */
+ddl_opt_with_data
+	: tokenWITH tokenDATA
+	{
+		$$ = false
+	}
+	| tokenWITH tokenNO tokenDATA
+	{
+		$$ = true
+	}
+	| /* Empty */
+	{
+		$$ = false
+	}
+
+/* a SELECT without INTO creates no table and is skipped, so is a WITH clause followed by another statement */
+ddl_select
+	: tokenSELECT tokenQuery
+	{
+		l := yylex.(*lexer)
+		if def := l.selectInto($<pos>1, $<pos>2, $2); def != nil {
+			l.ast = append(l.ast, def)
+		}
+	}
+	| tokenWITH tokenQuery
+	{
+		l := yylex.(*lexer)
+		if def := l.selectInto($<pos>1, $<pos>2, $2); def != nil {
+			l.ast = append(l.ast, def)
+		}
+	}
+
+ddl_create_type
+	: tokenCreate tokenTYPE ddl_tableName tokenAS tokenLeftParen ddl_opt_type_attributes tokenRightParen
+	{
+		l := yylex.(*lexer)
+		l.types = append(l.types, &compositeType{pos: $<pos>1, Schema: $3.Schema, Name: $3.Table, key: $3.key, attributes: $6})
+	}
+
+ddl_opt_type_attributes
+	: ddl_type_attributes
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_type_attributes
+	: ddl_type_attribute
+	{
+		$$ = []*TableColumn{$1.Column()}
+	}
+	| ddl_type_attributes tokenComma ddl_type_attribute
+	{
+		$$ = append($1, $3.Column())
+	}
+
+ddl_type_attribute
+	: ddl_column_name ddl_data_type
+	{
+		$$ = columnObj{pos: $<pos>1, Name: $1, DataType: $2}
+	}
+	| ddl_column_name ddl_data_type tokenCOLLATE ddl_collation
+	{
+		$$ = columnObj{pos: $<pos>1, Name: $1, DataType: $2, Collation: $4.Name, CollationSchema: $4.Schema}
 	}
 ddl_create_table_header
-	 :tokenCreate tokenTable ddl_tableName
+	 :tokenCreate ddl_opt_temp tokenTable ddl_tableName
 	 {
-		$$ = $3
+		$$ = $4
+		$$.Persistence = Persistence($2)
 	 }
-	 |tokenCreate tokenTable tokenIF tokenNOT tokenEXISTS ddl_tableName
+	 |tokenCreate ddl_opt_temp tokenTable tokenIF tokenNOT tokenEXISTS ddl_tableName
 	 {
-		$$ = $6
+		$$ = $7
+		$$.Persistence = Persistence($2)
+		$$.IfNotExists = true
 	 }
 
+/* GLOBAL and LOCAL are ignored by postgres */
+ddl_opt_temp
+	: tokenTEMPORARY
+	{
+		$$ = string(PersistenceTemporary)
+	}
+	| tokenTEMP
+	{
+		$$ = string(PersistenceTemporary)
+	}
+	| tokenLOCAL tokenTEMPORARY
+	{
+		$$ = string(PersistenceTemporary)
+	}
+	| tokenLOCAL tokenTEMP
+	{
+		$$ = string(PersistenceTemporary)
+	}
+	| tokenGLOBAL tokenTEMPORARY
+	{
+		$$ = string(PersistenceTemporary)
+	}
+	| tokenGLOBAL tokenTEMP
+	{
+		$$ = string(PersistenceTemporary)
+	}
+	| tokenUNLOGGED
+	{
+		$$ = string(PersistenceUnlogged)
+	}
+	| /* Empty */
+	{
+		$$ = string(PersistencePermanent)
+	}
+
+ddl_opt_create_table_body
+	: ddl_create_table_body
+	| /* Empty */
+	{
+		$$ = tableBody{}
+	}
+
+ddl_opt_inherits
+	: tokenINHERITS tokenLeftParen ddl_table_names tokenRightParen
+	{
+		$$ = $3
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_table_names
+	: ddl_tableName
+	{
+		$$ = []tableHeader{$1}
+	}
+	| ddl_table_names tokenComma ddl_tableName
+	{
+		$$ = append($1, $3)
+	}
+
+ddl_create_table_options
+	: ddl_opt_partition_spec ddl_opt_table_with ddl_opt_on_commit ddl_opt_tablespace
+	{
+		$$ = tableOptions{partitionBy: $1, with: $2, onCommit: OnCommitAction($3), onCommitPos: $<pos>3, tablespace: $4}
+	}
+
+/* WITHOUT OIDS is the only behavior since postgres 12 and is accepted for compatibility */
+ddl_opt_table_with
+	: tokenWITH ddl_reloptions
+	{
+		$$ = $2
+	}
+	| tokenWITHOUT tokenOIDS
+	{
+		$$ = nil
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_opt_tablespace
+	: tokenTABLESPACE ddl_symbol
+	{
+		$$ = $2
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_opt_partition_spec
+	: tokenPARTITION tokenBY ddl_symbol tokenLeftParen ddl_partition_keys tokenRightParen
+	{
+		$$ = yylex.(*lexer).newPartitionSpec($<pos>3, $3, $5)
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_partition_keys
+	: ddl_partition_key
+	{
+		$$ = []*PartitionKey{$1}
+	}
+	| ddl_partition_keys tokenComma ddl_partition_key
+	{
+		$$ = append($1, $3)
+	}
+
+ddl_partition_key
+	: ddl_index_elem_expr ddl_opt_collate ddl_opt_opclass
+	{
+		$$ = &PartitionKey{Column: $1.Column, Expr: $1.Expr, Source: $1.Source, Collation: $2, OpClass: $3}
+	}
+
+ddl_opt_collate
+	: tokenCOLLATE ddl_collation
+	{
+		$$ = $2.String()
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+/* column options and table constraints of a partition */
+ddl_opt_typed_table_body
+	: tokenLeftParen ddl_typed_table_body tokenRightParen
+	{
+		$$ = $2
+	}
+	| /* Empty */
+	{
+		$$ = tableBody{}
+	}
+
+ddl_typed_table_body
+	: ddl_typed_table_column
+	{
+		$$ = tableBody{}
+		$$.addColumn($1)
+	}
+	| ddl_typed_table_body tokenComma ddl_typed_table_column
+	{
+		$$.addColumn($3)
+	}
+	| ddl_table_constraint
+	{
+		$$ = tableBody{}
+		$$.addConstraint($<pos>1, $1)
+	}
+	| ddl_typed_table_body tokenComma ddl_table_constraint
+	{
+		$$.addConstraint($<pos>3, $3)
+	}
+
+ddl_typed_table_column
+	: ddl_column_name ddl_opt_with_options ddl_column_constraint
+	{
+		if $3.constraintName != "" {
+			yylex.(*lexer).errorAt($3.constraintPos, "CONSTRAINT %s is not followed by a constraint", $3.constraintName)
+		}
+		$$ = $3
+		$$.pos = $<pos>1
+		$$.Name = $1
+	}
+	| ddl_column_name ddl_opt_with_options
+	{
+		$$ = columnObj{pos: $<pos>1, Name: $1}
+	}
+
+ddl_opt_with_options
+	: tokenWITH tokenOPTIONS
+	{
+		$$ = true
+	}
+	| /* Empty */
+	{
+		$$ = false
+	}
+
+ddl_partition_bound
+	: tokenFOR tokenVALUES tokenIN tokenLeftParen ddl_exprs tokenRightParen
+	{
+		$$ = &PartitionBound{In: $5}
+	}
+	| tokenFOR tokenVALUES tokenFROM tokenLeftParen ddl_exprs tokenRightParen tokenTO tokenLeftParen ddl_exprs tokenRightParen
+	{
+		$$ = &PartitionBound{From: $5, To: $9}
+	}
+	| tokenFOR tokenVALUES tokenWITH tokenLeftParen ddl_hash_bound tokenRightParen
+	{
+		$$ = $5
+		yylex.(*lexer).checkHashBound($$, $<pos>5)
+	}
+	| tokenDEFAULT
+	{
+		$$ = &PartitionBound{Default: true}
+	}
+
+ddl_hash_bound
+	: ddl_symbol tokenNumber
+	{
+		$$ = &PartitionBound{Modulus: -1, Remainder: -1}
+		yylex.(*lexer).setHashBound($$, $<pos>1, $1, $2)
+	}
+	| ddl_hash_bound tokenComma ddl_symbol tokenNumber
+	{
+		yylex.(*lexer).setHashBound($$, $<pos>3, $3, $4)
+	}
+
+ddl_opt_on_commit
+	: tokenON tokenCOMMIT tokenDROP
+	{
+		$$ = string(OnCommitDrop)
+	}
+	| tokenON tokenCOMMIT tokenDELETE tokenROWS
+	{
+		$$ = string(OnCommitDeleteRows)
+	}
+	| tokenON tokenCOMMIT tokenPRESERVE tokenROWS
+	{
+		$$ = string(OnCommitPreserveRows)
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
 ddl_tableName
 	: ddl_symbol
 	{
 		$$.Table = $1
+		$$.key = TableName{Table: yylex.(*lexer).nameKey($<pos>1, $1)}
 	}
 	| ddl_symbol tokenDot ddl_symbol
 	{
+		l := yylex.(*lexer)
 		$$.Schema = $1
 		$$.Table = $3
+		$$.key = TableName{l.nameKey($<pos>1, $1), l.nameKey($<pos>3, $3)}
 	}
 
 ddl_create_table_body
 	: ddl_table_column
 	{
-		$$.columns = []columnObj{$1}
+		$$ = tableBody{}
+		$$.addColumn($1)
 	}
 	| ddl_create_table_body tokenComma ddl_table_column
 	{
-		$$.columns = append($$.columns,$3)
+		$$.addColumn($3)
 	}
 	| ddl_table_constraint
 	{
-		$$.constraint = $1
+		$$ = tableBody{}
+		$$.addConstraint($<pos>1, $1)
 	}
 	| ddl_create_table_body tokenComma ddl_table_constraint
 	{
-		$$.constraint = combineConstraint($$.constraint,$3)
+		$$.addConstraint($<pos>3, $3)
+	}
+	| ddl_table_like
+	{
+		$$ = tableBody{likes: []*LikeClause{$1}}
+	}
+	| ddl_create_table_body tokenComma ddl_table_like
+	{
+		$3.at = len($$.columns)
+		$$.likes = append($$.likes, $3)
+	}
+
+ddl_table_like
+	: tokenLIKE ddl_tableName
+	{
+		$$ = &LikeClause{Source: TableName{$2.Schema, $2.Table}, sourceKey: $2.key}
+	}
+	| ddl_table_like tokenINCLUDING ddl_symbol
+	{
+		yylex.(*lexer).setLikeOption($$, $<pos>3, true, $3)
+	}
+	| ddl_table_like tokenEXCLUDING ddl_symbol
+	{
+		yylex.(*lexer).setLikeOption($$, $<pos>3, false, $3)
 	}
 
 ddl_table_column
 	: ddl_column_name ddl_data_type ddl_column_constraint
 	{
+	 if $3.constraintName != "" {
+		yylex.(*lexer).errorAt($3.constraintPos, "CONSTRAINT %s is not followed by a constraint", $3.constraintName)
+	 }
 	 $$ = $3
+	 $$.pos = $<pos>1
 	 $$.Name = $1
-	 $$.Type = $2
+	 $$.DataType = $2
 	}
 	| ddl_column_name ddl_data_type
 	{
+	  $$.pos = $<pos>1
 	  $$.Name = $1
-	  $$.Type = $2
+	  $$.DataType = $2
+	}
+	| ddl_column_name ddl_data_type ddl_generic_options ddl_column_constraint
+	{
+		if $4.constraintName != "" {
+			yylex.(*lexer).errorAt($4.constraintPos, "CONSTRAINT %s is not followed by a constraint", $4.constraintName)
+		}
+		$$ = $4
+		$$.pos = $<pos>1
+		$$.Name = $1
+		$$.DataType = $2
+		$$.Options = $3
+	}
+	| ddl_column_name ddl_data_type ddl_generic_options
+	{
+		$$ = columnObj{pos: $<pos>1, Name: $1, DataType: $2, Options: $3}
+	}
+
+ddl_opt_generic_options
+	: ddl_generic_options
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_generic_options
+	: tokenOPTIONS tokenLeftParen ddl_generic_option_list tokenRightParen
+	{
+		$$ = $3
+	}
+
+ddl_generic_option_list
+	: ddl_generic_option_name tokenPgValue
+	{
+		$$ = GenericOptions{$1: $2}
+	}
+	| ddl_generic_option_list tokenComma ddl_generic_option_name tokenPgValue
+	{
+		yylex.(*lexer).addGenericOption($$, $<pos>3, $3, $4)
+	}
+
+/* like postgres, an option name is folded to lower case unless it is quoted */
+ddl_generic_option_name
+	: tokenString
+	{
+		$$ = strings.ToLower($1)
+	}
+	| tokenPgSymbol
+	| ddl_unreserved_keyword
+	{
+		$$ = strings.ToLower($1)
+	}
+	| ddl_col_name_keyword
+	{
+		$$ = strings.ToLower($1)
 	}
 
 ddl_column_name
 	: ddl_symbol
 ddl_data_type
-	: ddl_symbol
-	| ddl_symbol tokenDot ddl_symbol
+	: ddl_simple_type
+	| ddl_simple_type ddl_array_bounds
+	{
+		$$ = $1
+		$$.ArrayDims = $2
+	}
+	| ddl_simple_type tokenARRAY
+	{
+		$$ = $1
+		$$.ArrayDims = []int{-1}
+	}
+	| ddl_simple_type tokenARRAY tokenLeftBracket tokenNumber tokenRightBracket
 	{
-		$$= __yyfmt__.Sprintf("%s.%s",$1,$3)
+		$$ = $1
+		$$.ArrayDims = []int{arrayBound($4)}
 	}
 
-ddl_column_constraint
-	: tokenUNIQUE
+ddl_simple_type
+	: ddl_type_name
+	| ddl_type_name tokenLeftParen ddl_type_modifiers tokenRightParen
 	{
-		$$.Unique = true
+		$$ = $1
+		$$.Modifiers = $3
 	}
-	| ddl_column_primary_key
+	| ddl_const_type
+
+ddl_type_name
+	: ddl_type_symbol
 	{
-		$$.PrimaryKey = true
+		$$ = &DataType{Name: $1, quoted: yylex.(*lexer).quoted($<pos>1)}
 	}
-	| tokenNOT tokenNULL
+	| ddl_type_symbol tokenDot ddl_symbol
+	{
+		$$ = &DataType{Schema: $1, Name: $3, quoted: yylex.(*lexer).quoted($<pos>3)}
+	}
+
+ddl_const_type
+	: tokenDOUBLE tokenPRECISION
 	{
-		$$.NotNull = true
+		$$ = &DataType{Name: $1 + " " + $2}
 	}
-	| tokenDEFAULT ddl_default_expr
+	| ddl_character_type
 	{
+		$$ = &DataType{Name: $1}
 	}
-	| ddl_column_constraint tokenUNIQUE
+	| ddl_character_type tokenLeftParen tokenNumber tokenRightParen
 	{
-		$$.PrimaryKey = true
+		$$ = &DataType{Name: $1, Modifiers: []string{$3}}
 	}
-	| ddl_column_constraint ddl_column_primary_key
+	| ddl_bit_type
 	{
-		$$.PrimaryKey = true
+		$$ = &DataType{Name: $1}
 	}
-	| ddl_column_constraint tokenNOT tokenNULL
+	| ddl_bit_type tokenLeftParen tokenNumber tokenRightParen
+	{
+		$$ = &DataType{Name: $1, Modifiers: []string{$3}}
+	}
+	| ddl_datetime_word ddl_opt_timezone
+	{
+		$$ = &DataType{Name: $1 + $2}
+	}
+	| ddl_datetime_word tokenLeftParen tokenNumber tokenRightParen ddl_opt_timezone
+	{
+		$$ = &DataType{Name: $1 + $5, Modifiers: []string{$3}}
+	}
+	| tokenINTERVAL
+	{
+		$$ = &DataType{Name: $1}
+	}
+	| tokenINTERVAL tokenLeftParen tokenNumber tokenRightParen
+	{
+		$$ = &DataType{Name: $1, Modifiers: []string{$3}}
+	}
+	| tokenINTERVAL ddl_interval_fields
+	{
+		$$ = &DataType{Name: $1, IntervalFields: $2}
+	}
+	| tokenINTERVAL ddl_interval_fields tokenLeftParen tokenNumber tokenRightParen
 	{
-		$$.NotNull = true
+		$$ = &DataType{Name: $1, IntervalFields: $2, Modifiers: []string{$4}}
 	}
-	| ddl_column_constraint tokenDEFAULT ddl_default_expr
 
-ddl_column_primary_key
-	: tokenPRIMARY tokenKEY{}
+ddl_character_type
+	: ddl_character_word
+	| ddl_character_word tokenVARYING
+	{
+		$$ = $1 + " " + $2
+	}
 
-ddl_default_expr
-	: ddl_value
-	| ddl_symbol tokenDot ddl_symbol tokenLeftParen tokenRightParen
-	| ddl_symbol tokenLeftParen tokenRightParen
+ddl_character_word
+	: tokenCHARACTER
+	| tokenCHAR
+	| tokenNCHAR
+	| tokenNATIONAL tokenCHARACTER
+	{
+		$$ = $1 + " " + $2
+	}
+	| tokenNATIONAL tokenCHAR
+	{
+		$$ = $1 + " " + $2
+	}
 
-ddl_table_constraint
-	: tokenUNIQUE tokenLeftParen ddl_column_names tokenRightParen
+ddl_bit_type
+	: tokenBIT
+	| tokenBIT tokenVARYING
 	{
-		$$.Uniques = append($$.Uniques,$3)
+		$$ = $1 + " " + $2
 	}
 
-ddl_column_names
-	: ddl_column_name
+ddl_datetime_word
+	: tokenTIMESTAMP
+	| tokenTIME
+
+ddl_opt_timezone
+	: tokenWITH tokenTIME tokenZONE
 	{
-		$$= []string{$1}
+		$$ = " " + $1 + " " + $2 + " " + $3
 	}
-	| ddl_column_names tokenComma ddl_column_name
+	| tokenWITHOUT tokenTIME tokenZONE
 	{
-		$$= append($1,$3)
+		$$ = " " + $1 + " " + $2 + " " + $3
+	}
+	| /* Empty */
+	{
+		$$ = ""
 	}
 
-ddl_symbol
-	: tokenString
+ddl_interval_fields
+	: ddl_interval_unit
+	| ddl_interval_unit tokenTO ddl_interval_unit
+	{
+		$$ = $1 + " " + $2 + " " + $3
+	}
+
+ddl_interval_unit
+	: tokenYEAR
+	| tokenMONTH
+	| tokenDAY
+	| tokenHOUR
+	| tokenMINUTE
+	| tokenSECOND
+
+ddl_array_bounds
+	: tokenLeftBracket tokenRightBracket
+	{
+		$$ = []int{-1}
+	}
+	| tokenLeftBracket tokenNumber tokenRightBracket
+	{
+		$$ = []int{arrayBound($2)}
+	}
+	| ddl_array_bounds tokenLeftBracket tokenRightBracket
+	{
+		$$ = append($1,-1)
+	}
+	| ddl_array_bounds tokenLeftBracket tokenNumber tokenRightBracket
+	{
+		$$ = append($1,arrayBound($3))
+	}
+
+ddl_type_modifiers
+	: ddl_value
+	{
+		$$ = []string{$1}
+	}
+	| ddl_type_modifiers tokenComma ddl_value
+	{
+		$$ = append($1,$3)
+	}
+
+ddl_column_constraint
+	: ddl_column_unique
+	{
+		$$.Uniques = []*Unique{$1}
+		$$.attrs = &constraintAttributes{target: &$1.Deferrability}
+	}
+	| ddl_column_primary_key ddl_index_params
+	{
+		$$.PrimaryKey = &PrimaryKey{IndexParameters: $2}
+		$$.attrs = &constraintAttributes{target: &$$.PrimaryKey.Deferrability}
+	}
+	| tokenNOT tokenNULL
+	{
+		$$.NotNull = &NotNull{}
+		$$.notNullPos = $<pos>1
+	}
+	| tokenNULL
+	{
+		$$.Null = true
+		$$.nullPos = $<pos>1
+	}
+	| tokenDEFAULT ddl_b_expr
+	{
+		$$.Default = yylex.(*lexer).source($2)
+		$$.DefaultExpr = $2
+		$$.defaultPos = $<pos>1
+	}
+	| tokenCONSTRAINT ddl_symbol
+	{
+		$$.constraintName = $2
+		$$.constraintPos = $<pos>1
+	}
+	| ddl_column_constraint ddl_column_unique
+	{
+		$2.Name = $$.nextConstraint()
+		$$.Uniques = append($$.Uniques, $2)
+		$$.attrs = &constraintAttributes{target: &$2.Deferrability}
+	}
+	| ddl_column_constraint ddl_column_primary_key ddl_index_params
+	{
+		if $$.PrimaryKey != nil {
+			$$.repeat($<pos>2, "PRIMARY KEY")
+		}
+		$$.PrimaryKey = &PrimaryKey{Name: $$.nextConstraint(), IndexParameters: $3}
+		$$.attrs = &constraintAttributes{target: &$$.PrimaryKey.Deferrability}
+	}
+	| ddl_column_constraint tokenNOT tokenNULL
+	{
+		if $$.NotNull != nil {
+			$$.repeat($<pos>2, "NOT NULL")
+		}
+		$$.NotNull = &NotNull{Name: $$.nextConstraint()}
+		$$.notNullPos = $<pos>2
+	}
+	| ddl_column_constraint tokenNULL
+	{
+		$$.nextConstraint()
+		if $$.Null {
+			$$.repeat($<pos>2, "NULL")
+		}
+		$$.Null = true
+		$$.nullPos = $<pos>2
+	}
+	| ddl_column_constraint tokenDEFAULT ddl_b_expr
+	{
+		$$.nextConstraint()
+		if $$.DefaultExpr != nil {
+			$$.repeat($<pos>2, "DEFAULT")
+		}
+		$$.Default = yylex.(*lexer).source($3)
+		$$.DefaultExpr = $3
+		$$.defaultPos = $<pos>2
+	}
+	| ddl_column_constraint tokenCONSTRAINT ddl_symbol
+	{
+		if $$.constraintName != "" {
+			yylex.(*lexer).errorAt($$.constraintPos, "CONSTRAINT %s is not followed by a constraint", $$.constraintName)
+		}
+		$$.constraintName = $3
+		$$.constraintPos = $<pos>2
+		$$.attrs = nil
+	}
+	| ddl_check
+	{
+		$$.Checks = []*Check{$1}
+	}
+	| ddl_column_constraint ddl_check
+	{
+		$2.Name = $$.nextConstraint()
+		$$.Checks = append($$.Checks, $2)
+	}
+	| ddl_references
+	{
+		$$.ForeignKeys = []*ForeignKey{$1}
+		$$.attrs = &constraintAttributes{target: &$1.Deferrability}
+	}
+	| ddl_column_constraint ddl_references
+	{
+		$2.Name = $$.nextConstraint()
+		$$.ForeignKeys = append($$.ForeignKeys, $2)
+		$$.attrs = &constraintAttributes{target: &$2.Deferrability}
+	}
+	| tokenCOLLATE ddl_collation
+	{
+		$$.CollationSchema = $2.Schema
+		$$.Collation = $2.Name
+	}
+	| ddl_column_constraint tokenCOLLATE ddl_collation
+	{
+		// COLLATE is not a constraint and can not be named
+		if name := $$.nextConstraint(); name != "" {
+			yylex.(*lexer).errorAt($$.constraintPos, "CONSTRAINT %s is not followed by a constraint", name)
+		}
+		$$.CollationSchema = $3.Schema
+		$$.Collation = $3.Name
+	}
+	| ddl_generated
+	| ddl_column_constraint ddl_generated
+	{
+		$$.nextConstraint()
+		if $2.Identity != nil {
+			$$.Identity, $$.identityPos = $2.Identity, $2.identityPos
+		} else {
+			$$.Generated, $$.GeneratedExpr, $$.GeneratedStored = $2.Generated, $2.GeneratedExpr, $2.GeneratedStored
+			$$.generatedPos = $2.generatedPos
+		}
+	}
+	| ddl_column_constraint ddl_constraint_attribute
+	{
+		// constraint attributes apply to the constraint before them
+		if $$.attrs == nil {
+			yylex.(*lexer).errorAt($2.pos, "misplaced %s clause", $2.clause)
+		} else if err := $$.attrs.add($2); err != nil {
+			yylex.(*lexer).errorAt($2.pos, "%s", err)
+		}
+	}
+
+ddl_column_primary_key
+	: tokenPRIMARY tokenKEY{}
+
+ddl_column_unique
+	: tokenUNIQUE ddl_opt_nulls_not_distinct ddl_index_params
+	{
+		$$ = &Unique{NullsNotDistinct: $2, IndexParameters: $3}
+	}
+
+ddl_opt_nulls_not_distinct
+	: tokenNULLS tokenDISTINCT
+	{
+		$$ = false
+	}
+	| tokenNULLS tokenNOT tokenDISTINCT
+	{
+		$$ = true
+	}
+	| /* Empty */
+	{
+		$$ = false
+	}
+
+ddl_index_params
+	: ddl_opt_include ddl_opt_with_params ddl_opt_index_tablespace
+	{
+		$$ = IndexParameters{Include: $1, With: $2, Tablespace: $3}
+	}
+
+ddl_opt_index_tablespace
+	: tokenUSING tokenINDEX tokenTABLESPACE ddl_symbol
+	{
+		$$ = $4
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_collation
+	: ddl_symbol
+	{
+		$$ = qualifiedName{Name: $1}
+	}
+	| ddl_symbol tokenDot ddl_symbol
+	{
+		$$ = qualifiedName{Schema: $1, Name: $3}
+	}
+
+ddl_generated
+	: tokenGENERATED ddl_generated_when tokenAS tokenIDENTITY ddl_opt_seq_options
+	{
+		$$ = columnObj{Identity: &Identity{Always: $2, Sequence: *$5}, identityPos: $<pos>1}
+	}
+	| tokenGENERATED ddl_generated_when tokenAS tokenLeftParen ddl_a_expr tokenRightParen ddl_opt_generated_stored
+	{
+		if !$2 {
+			yylex.(*lexer).errorAt($<pos>1, "for a generated column, GENERATED ALWAYS must be specified")
+		}
+		$$ = columnObj{Generated: yylex.(*lexer).source($5), GeneratedExpr: $5, GeneratedStored: $7, generatedPos: $<pos>1}
+	}
+
+ddl_generated_when
+	: tokenALWAYS
+	{
+		$$ = true
+	}
+	| tokenBY tokenDEFAULT
+	{
+		$$ = false
+	}
+
+ddl_opt_generated_stored
+	: tokenSTORED
+	{
+		$$ = true
+	}
+	| tokenVIRTUAL
+	{
+		$$ = false
+	}
+	| /* Empty */
+	{
+		$$ = false
+	}
+
+ddl_opt_seq_options
+	: tokenLeftParen ddl_seq_options tokenRightParen
+	{
+		$$ = $2
+	}
+	| /* Empty */
+	{
+		$$ = &SequenceOptions{}
+	}
+
+ddl_seq_options
+	: /* Empty */
+	{
+		$$ = &SequenceOptions{}
+	}
+	| ddl_seq_options tokenAS ddl_simple_type
+	{
+		$$.Type = $3
+	}
+	| ddl_seq_options tokenSTART ddl_opt_with ddl_signed_number
+	{
+		$$.Start = yylex.(*lexer).sequenceValue($<pos>4, $4)
+	}
+	| ddl_seq_options tokenINCREMENT ddl_opt_by ddl_signed_number
+	{
+		$$.Increment = yylex.(*lexer).sequenceValue($<pos>4, $4)
+	}
+	| ddl_seq_options tokenMINVALUE ddl_signed_number
+	{
+		$$.MinValue = yylex.(*lexer).sequenceValue($<pos>3, $3)
+	}
+	| ddl_seq_options tokenNO tokenMINVALUE
+	{
+		$$.MinValue = nil
+	}
+	| ddl_seq_options tokenMAXVALUE ddl_signed_number
+	{
+		$$.MaxValue = yylex.(*lexer).sequenceValue($<pos>3, $3)
+	}
+	| ddl_seq_options tokenNO tokenMAXVALUE
+	{
+		$$.MaxValue = nil
+	}
+	| ddl_seq_options tokenCACHE ddl_signed_number
+	{
+		$$.Cache = yylex.(*lexer).sequenceValue($<pos>3, $3)
+	}
+	| ddl_seq_options tokenCYCLE
+	{
+		$$.Cycle = true
+	}
+	| ddl_seq_options tokenNO tokenCYCLE
+	{
+		$$.Cycle = false
+	}
+	| ddl_seq_options tokenSEQUENCE tokenNAME ddl_symbol
+	{
+		$$.Name = $4
+	}
+	| ddl_seq_options tokenSEQUENCE tokenNAME ddl_symbol tokenDot ddl_symbol
+	{
+		$$.Name = $4 + "." + $6
+	}
+
+ddl_opt_with
+	: tokenWITH
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_opt_by
+	: tokenBY
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_signed_number
+	: tokenNumber
+	| tokenMinus tokenNumber
+	{
+		$$ = "-" + $2
+	}
+
+ddl_a_expr
+	: ddl_c_expr
+	| ddl_a_expr tokenTypeCast ddl_data_type
+	{
+		$$ = &TypeCast{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Type: $3}
+	}
+	| tokenPlus ddl_a_expr %prec tokenUnaryMinus
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| tokenMinus ddl_a_expr %prec tokenUnaryMinus
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| tokenOp ddl_a_expr
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| ddl_a_expr tokenPlus ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenMinus ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenStar ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenSlash ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenPercent ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenCaret ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenLess ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenGreater ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenEquals ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenLessEquals ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenGreaterEquals ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenNotEquals ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenOp ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenAND ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenOR ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| tokenNOT ddl_a_expr
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| ddl_a_expr tokenLIKE ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenNOT tokenLIKE ddl_a_expr %prec tokenLIKE
+	{
+		$$ = newBinaryExpr($2 + " " + $3, $1, $4)
+	}
+	| ddl_a_expr tokenILIKE ddl_a_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_a_expr tokenNOT tokenILIKE ddl_a_expr %prec tokenILIKE
+	{
+		$$ = newBinaryExpr($2 + " " + $3, $1, $4)
+	}
+	| ddl_a_expr tokenIS tokenNULL
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Test: IsNull}
+	}
+	| ddl_a_expr tokenIS tokenNOT tokenNULL
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Not: true, Test: IsNull}
+	}
+	| ddl_a_expr tokenISNULL
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Test: IsNull}
+	}
+	| ddl_a_expr tokenNOTNULL
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Not: true, Test: IsNull}
+	}
+	| ddl_a_expr tokenIS tokenTRUE
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Test: IsTrue}
+	}
+	| ddl_a_expr tokenIS tokenNOT tokenTRUE
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Not: true, Test: IsTrue}
+	}
+	| ddl_a_expr tokenIS tokenFALSE
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Test: IsFalse}
+	}
+	| ddl_a_expr tokenIS tokenNOT tokenFALSE
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Not: true, Test: IsFalse}
+	}
+	| ddl_a_expr tokenIS tokenUNKNOWN
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Test: IsUnknown}
+	}
+	| ddl_a_expr tokenIS tokenNOT tokenUNKNOWN
+	{
+		$$ = &IsExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Not: true, Test: IsUnknown}
+	}
+	| ddl_a_expr tokenIS tokenDISTINCT tokenFROM ddl_a_expr %prec tokenIS
+	{
+		$$ = newBinaryExpr("IS DISTINCT FROM", $1, $5)
+	}
+	| ddl_a_expr tokenIS tokenNOT tokenDISTINCT tokenFROM ddl_a_expr %prec tokenIS
+	{
+		$$ = newBinaryExpr("IS NOT DISTINCT FROM", $1, $6)
+	}
+	| ddl_a_expr tokenBETWEEN ddl_b_expr tokenAND ddl_a_expr %prec tokenBETWEEN
+	{
+		$$ = &BetweenExpr{span: span{$1.Pos(), $5.End()}, Arg: $1, Low: $3, High: $5}
+	}
+	| ddl_a_expr tokenNOT tokenBETWEEN ddl_b_expr tokenAND ddl_a_expr %prec tokenBETWEEN
+	{
+		$$ = &BetweenExpr{span: span{$1.Pos(), $6.End()}, Arg: $1, Not: true, Low: $4, High: $6}
+	}
+	| ddl_a_expr tokenIN tokenLeftParen ddl_exprs tokenRightParen
+	{
+		$$ = &InExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, List: $4}
+	}
+	| ddl_a_expr tokenNOT tokenIN tokenLeftParen ddl_exprs tokenRightParen %prec tokenIN
+	{
+		$$ = &InExpr{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Not: true, List: $5}
+	}
+
+/* b_expr is a restricted a_expr without boolean operators, for the places where NOT or IN may follow */
+ddl_b_expr
+	: ddl_c_expr
+	| ddl_b_expr tokenTypeCast ddl_data_type
+	{
+		$$ = &TypeCast{span: span{$1.Pos(), ruleEnd(yylex, yyrcvr.char)}, Arg: $1, Type: $3}
+	}
+	| tokenPlus ddl_b_expr %prec tokenUnaryMinus
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| tokenMinus ddl_b_expr %prec tokenUnaryMinus
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| tokenOp ddl_b_expr
+	{
+		$$ = newUnaryExpr($<pos>1, $1, $2)
+	}
+	| ddl_b_expr tokenPlus ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenMinus ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenStar ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenSlash ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenPercent ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenCaret ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenLess ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenGreater ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenEquals ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenLessEquals ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenGreaterEquals ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenNotEquals ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenOp ddl_b_expr
+	{
+		$$ = newBinaryExpr($2, $1, $3)
+	}
+	| ddl_b_expr tokenIS tokenDISTINCT tokenFROM ddl_b_expr %prec tokenIS
+	{
+		$$ = newBinaryExpr("IS DISTINCT FROM", $1, $5)
+	}
+	| ddl_b_expr tokenIS tokenNOT tokenDISTINCT tokenFROM ddl_b_expr %prec tokenIS
+	{
+		$$ = newBinaryExpr("IS NOT DISTINCT FROM", $1, $6)
+	}
+
+ddl_c_expr
+	: ddl_column_ref
+	| ddl_const
+	| ddl_func_call
+	| tokenLeftParen ddl_a_expr tokenRightParen
+	{
+		$$ = &ParenExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, X: $2}
+	}
+	| tokenCAST tokenLeftParen ddl_a_expr tokenAS ddl_data_type tokenRightParen
+	{
+		$$ = &TypeCast{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Arg: $3, Type: $5}
+	}
+	| ddl_case_expr
+	| tokenARRAY ddl_array_expr
+	{
+		array := $2.(*ArrayExpr)
+		array.pos = $<pos>1
+		array.Nested = false
+		$$ = array
+	}
+	| tokenROW tokenLeftParen tokenRightParen
+	{
+		$$ = &RowExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}}
+	}
+	| tokenROW tokenLeftParen ddl_exprs tokenRightParen
+	{
+		$$ = &RowExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Args: $3}
+	}
+	| tokenLeftParen ddl_a_expr tokenComma ddl_exprs tokenRightParen
+	{
+		$$ = &RowExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Args: append([]Expr{$2}, $4...), Implicit: true}
+	}
+
+ddl_const
+	: tokenPgValue
+	{
+		$$ = &Literal{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralString, Value: $1}
+	}
+	| tokenNumber
+	{
+		$$ = &Literal{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralNumber, Value: $1}
+	}
+	| tokenTRUE
+	{
+		$$ = &Literal{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralBool, Value: $1}
+	}
+	| tokenFALSE
+	{
+		$$ = &Literal{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralBool, Value: $1}
+	}
+	| tokenNULL
+	{
+		$$ = &Literal{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Kind: LiteralNull, Value: $1}
+	}
+	| ddl_type_symbol tokenPgValue
+	{
+		end := ruleEnd(yylex, yyrcvr.char)
+		arg := &Literal{span: span{$<pos>2, end}, Kind: LiteralString, Value: $2}
+		$$ = &TypeCast{span: span{$<pos>1, end}, Arg: arg, Type: &DataType{Name: $1, quoted: yylex.(*lexer).quoted($<pos>1)}}
+	}
+	| ddl_const_type tokenPgValue
+	{
+		end := ruleEnd(yylex, yyrcvr.char)
+		arg := &Literal{span: span{$<pos>2, end}, Kind: LiteralString, Value: $2}
+		$$ = &TypeCast{span: span{$<pos>1, end}, Arg: arg, Type: $1}
+	}
+
+ddl_func_call
+	: ddl_type_symbol tokenLeftParen tokenRightParen
+	{
+		$$ = &FuncCall{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Name: $1}
+	}
+	| ddl_type_symbol tokenLeftParen ddl_exprs tokenRightParen
+	{
+		$$ = &FuncCall{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Name: $1, Args: $3}
+	}
+	| ddl_symbol tokenDot ddl_symbol tokenLeftParen tokenRightParen
+	{
+		$$ = &FuncCall{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Schema: $1, Name: $3}
+	}
+	| ddl_symbol tokenDot ddl_symbol tokenLeftParen ddl_exprs tokenRightParen
+	{
+		$$ = &FuncCall{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Schema: $1, Name: $3, Args: $5}
+	}
+
+ddl_column_ref
+	: ddl_symbol
+	{
+		if name := strings.ToUpper($1); sqlValueFuncs[name] && !yylex.(*lexer).quoted($<pos>1) {
+			$$ = &SQLValueFunc{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Name: name}
+		} else {
+			$$ = &ColumnRef{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Names: []string{$1}}
+		}
+	}
+	| ddl_symbol tokenDot ddl_symbol
+	{
+		$$ = &ColumnRef{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Names: []string{$1, $3}}
+	}
+
+ddl_case_expr
+	: tokenCASE ddl_case_whens tokenEND
+	{
+		$$ = &CaseExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Whens: $2}
+	}
+	| tokenCASE ddl_case_whens tokenELSE ddl_a_expr tokenEND
+	{
+		$$ = &CaseExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Whens: $2, Else: $4}
+	}
+	| tokenCASE ddl_a_expr ddl_case_whens tokenEND
+	{
+		$$ = &CaseExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Arg: $2, Whens: $3}
+	}
+	| tokenCASE ddl_a_expr ddl_case_whens tokenELSE ddl_a_expr tokenEND
+	{
+		$$ = &CaseExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Arg: $2, Whens: $3, Else: $5}
+	}
+
+ddl_case_whens
+	: ddl_case_when
+	{
+		$$ = []*CaseWhen{$1}
+	}
+	| ddl_case_whens ddl_case_when
+	{
+		$$ = append($1, $2)
+	}
+
+ddl_case_when
+	: tokenWHEN ddl_a_expr tokenTHEN ddl_a_expr
+	{
+		$$ = &CaseWhen{Cond: $2, Result: $4}
+	}
+
+ddl_array_expr
+	: tokenLeftBracket tokenRightBracket
+	{
+		$$ = &ArrayExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Nested: true}
+	}
+	| tokenLeftBracket ddl_exprs tokenRightBracket
+	{
+		$$ = &ArrayExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Elems: $2, Nested: true}
+	}
+	| tokenLeftBracket ddl_array_exprs tokenRightBracket
+	{
+		$$ = &ArrayExpr{span: span{$<pos>1, ruleEnd(yylex, yyrcvr.char)}, Elems: $2, Nested: true}
+	}
+
+ddl_array_exprs
+	: ddl_array_expr
+	{
+		$$ = []Expr{$1}
+	}
+	| ddl_array_exprs tokenComma ddl_array_expr
+	{
+		$$ = append($1, $3)
+	}
+
+ddl_exprs
+	: ddl_a_expr
+	{
+		$$ = []Expr{$1}
+	}
+	| ddl_exprs tokenComma ddl_a_expr
+	{
+		$$ = append($1, $3)
+	}
+
+ddl_table_constraint
+	: ddl_table_constraint_elem ddl_opt_constraint_attributes
+	{
+		if pos, err := $$.setAttributes($2); err != nil {
+			yylex.(*lexer).errorAt(pos, "%s", err)
+		}
+	}
+	| tokenCONSTRAINT ddl_symbol ddl_table_constraint_elem ddl_opt_constraint_attributes
+	{
+		$$ = $3
+		$$.setName($2)
+		if pos, err := $$.setAttributes($4); err != nil {
+			yylex.(*lexer).errorAt(pos, "%s", err)
+		}
+	}
+
+ddl_table_constraint_elem
+	: tokenUNIQUE ddl_opt_nulls_not_distinct tokenLeftParen ddl_column_names tokenRightParen ddl_index_params
+	{
+		$$ = TableConstraint{Uniques: []*Unique{{Columns: $4, NullsNotDistinct: $2, IndexParameters: $6}}}
+	}
+	| ddl_column_primary_key tokenLeftParen ddl_column_names tokenRightParen ddl_index_params
+	{
+		$$ = TableConstraint{PrimaryKey: &PrimaryKey{Columns: $3, IndexParameters: $5}}
+	}
+	| tokenFOREIGN tokenKEY tokenLeftParen ddl_column_names tokenRightParen ddl_references
+	{
+		fk := $6
+		fk.Columns = $4
+		$$ = TableConstraint{ForeignKeys: []*ForeignKey{fk}}
+	}
+	| ddl_check
+	{
+		$$ = TableConstraint{Checks: []*Check{$1}}
+	}
+	| ddl_exclusion
+	{
+		$$ = TableConstraint{Exclusions: []*Exclusion{$1}}
+	}
+
+ddl_exclusion
+	: tokenEXCLUDE ddl_opt_index_method tokenLeftParen ddl_exclusion_elems tokenRightParen ddl_index_params ddl_opt_where
+	{
+		$$ = &Exclusion{
+			Method: $2,
+			Elements: $4,
+			IndexParameters: $6,
+			Where: $7,
+		}
+		if $7 != nil {
+			$$.WhereSource = yylex.(*lexer).source($7)
+		}
+	}
+
+ddl_opt_index_method
+	: tokenUSING ddl_symbol
+	{
+		$$ = $2
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_exclusion_elems
+	: ddl_exclusion_elem
+	{
+		$$ = []*ExclusionElem{$1}
+	}
+	| ddl_exclusion_elems tokenComma ddl_exclusion_elem
+	{
+		$$ = append($1, $3)
+	}
+
+ddl_exclusion_elem
+	: ddl_index_elem tokenWITH ddl_any_operator
+	{
+		$$ = $1
+		$$.Operator = $3
+	}
+
+ddl_index_elem
+	: ddl_index_elem_expr ddl_opt_opclass ddl_opt_asc_desc ddl_opt_nulls_order
+	{
+		$$ = $1
+		$$.OpClass = $2
+		$$.Order = $3
+		$$.NullsOrder = $4
+	}
+
+ddl_index_elem_expr
+	: ddl_symbol
+	{
+		$$ = &ExclusionElem{Column: $1}
+	}
+	| ddl_func_call
+	{
+		$$ = &ExclusionElem{Expr: $1, Source: yylex.(*lexer).source($1)}
+	}
+	| tokenLeftParen ddl_a_expr tokenRightParen
+	{
+		$$ = &ExclusionElem{Expr: $2, Source: yylex.(*lexer).source($2)}
+	}
+
+ddl_opt_opclass
+	: ddl_symbol
+	| ddl_symbol tokenDot ddl_symbol
+	{
+		$$ = $1 + "." + $3
+	}
+	| /* Empty */ %prec tokenEmptyOpclass
+	{
+		$$ = ""
+	}
+
+ddl_opt_asc_desc
+	: tokenASC
+	{
+		$$ = "ASC"
+	}
+	| tokenDESC
+	{
+		$$ = "DESC"
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_opt_nulls_order
+	: tokenNULLS tokenFIRST
+	{
+		$$ = "FIRST"
+	}
+	| tokenNULLS tokenLAST
+	{
+		$$ = "LAST"
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+ddl_any_operator
+	: tokenOp
+	| tokenEquals
+	| tokenLess
+	| tokenGreater
+	| tokenLessEquals
+	| tokenGreaterEquals
+	| tokenNotEquals
+	| tokenPlus
+	| tokenMinus
+	| tokenStar
+	| tokenSlash
+	| tokenPercent
+	| tokenCaret
+
+ddl_opt_include
+	: tokenINCLUDE tokenLeftParen ddl_column_names tokenRightParen
+	{
+		$$ = $3
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_opt_with_params
+	: tokenWITH ddl_reloptions
+	{
+		$$ = $2
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_reloptions
+	: tokenLeftParen ddl_reloption_list tokenRightParen
+	{
+		$$ = $2
+	}
+
+ddl_reloption_list
+	: ddl_reloption
+	{
+		$$ = StorageParameters{$1[0]: $1[1]}
+	}
+	| ddl_reloption_list tokenComma ddl_reloption
+	{
+		$$[$3[0]] = $3[1]
+	}
+
+/* storage parameter as [name, value] */
+ddl_reloption
+	: ddl_reloption_name tokenEquals ddl_reloption_value
+	{
+		$$ = []string{$1, $3}
+	}
+	| ddl_reloption_name
+	{
+		$$ = []string{$1, ""}
+	}
+
+ddl_reloption_name
+	: ddl_symbol
+	{
+		$$ = strings.ToLower($1)
+	}
+	| ddl_symbol tokenDot ddl_symbol
+	{
+		$$ = strings.ToLower($1 + "." + $3)
+	}
+
+ddl_reloption_value
+	: ddl_value
+	| tokenMinus tokenNumber
+	{
+		$$ = "-" + $2
+	}
+	| tokenTRUE
+	| tokenFALSE
+	| tokenON
+	| ddl_unreserved_keyword
+
+ddl_opt_where
+	: tokenWHERE tokenLeftParen ddl_a_expr tokenRightParen
+	{
+		$$ = $3
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_check
+	: tokenCHECK tokenLeftParen ddl_a_expr tokenRightParen ddl_opt_no_inherit
+	{
+		$$ = &Check{Expr: $3, Source: yylex.(*lexer).source($3), NoInherit: $5}
+	}
+
+ddl_opt_no_inherit
+	: tokenNO tokenINHERIT
+	{
+		$$ = true
+	}
+	| /* Empty */
+	{
+		$$ = false
+	}
+
+ddl_references
+	: tokenREFERENCES ddl_tableName ddl_opt_column_list ddl_key_match ddl_key_actions
+	{
+		$$ = newForeignKey($2, $3)
+		if $4 != "" {
+			$$.Match = MatchType($4)
+		}
+		if $5[0] != "" {
+			$$.OnDelete = ReferentialAction($5[0])
+		}
+		if $5[1] != "" {
+			$$.OnUpdate = ReferentialAction($5[1])
+		}
+	}
+
+ddl_opt_column_list
+	: tokenLeftParen ddl_column_names tokenRightParen
+	{
+		$$ = $2
+	}
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_key_match
+	: tokenMATCH tokenFULL
+	{
+		$$ = string(MatchFull)
+	}
+	| tokenMATCH tokenPARTIAL
+	{
+		$$ = string(MatchPartial)
+	}
+	| tokenMATCH tokenSIMPLE
+	{
+		$$ = string(MatchSimple)
+	}
+	| /* Empty */
+	{
+		$$ = ""
+	}
+
+/* ON DELETE and ON UPDATE actions, as [delete, update] */
+ddl_key_actions
+	: ddl_key_delete
+	{
+		$$ = []string{$1, ""}
+	}
+	| ddl_key_update
+	{
+		$$ = []string{"", $1}
+	}
+	| ddl_key_delete ddl_key_update
+	{
+		$$ = []string{$1, $2}
+	}
+	| ddl_key_update ddl_key_delete
+	{
+		$$ = []string{$2, $1}
+	}
+	| /* Empty */
+	{
+		$$ = []string{"", ""}
+	}
+
+ddl_key_delete
+	: tokenON tokenDELETE ddl_key_action
+	{
+		$$ = $3
+	}
+
+ddl_key_update
+	: tokenON tokenUPDATE ddl_key_action
+	{
+		$$ = $3
+	}
+
+ddl_key_action
+	: tokenNO tokenACTION
+	{
+		$$ = string(ActionNoAction)
+	}
+	| tokenRESTRICT
+	{
+		$$ = string(ActionRestrict)
+	}
+	| tokenCASCADE
+	{
+		$$ = string(ActionCascade)
+	}
+	| tokenSET tokenNULL
+	{
+		$$ = string(ActionSetNull)
+	}
+	| tokenSET tokenDEFAULT
+	{
+		$$ = string(ActionSetDefault)
+	}
+
+ddl_opt_constraint_attributes
+	: ddl_constraint_attributes
+	| /* Empty */
+	{
+		$$ = nil
+	}
+
+ddl_constraint_attributes
+	: ddl_constraint_attribute
+	{
+		$$ = []constraintAttribute{$1}
+	}
+	| ddl_constraint_attributes ddl_constraint_attribute
+	{
+		$$ = append($1, $2)
+	}
+
+ddl_constraint_attribute
+	: tokenDEFERRABLE
+	{
+		$$ = constraintAttribute{"DEFERRABLE", $<pos>1}
+	}
+	| tokenNOT tokenDEFERRABLE
+	{
+		$$ = constraintAttribute{"NOT DEFERRABLE", $<pos>1}
+	}
+	| tokenINITIALLY tokenDEFERRED
+	{
+		$$ = constraintAttribute{"INITIALLY DEFERRED", $<pos>1}
+	}
+	| tokenINITIALLY tokenIMMEDIATE
+	{
+		$$ = constraintAttribute{"INITIALLY IMMEDIATE", $<pos>1}
+	}
+
+ddl_column_names
+	: ddl_column_name
+	{
+		$$= []string{$1}
+	}
+	| ddl_column_names tokenComma ddl_column_name
+	{
+		$$= append($1,$3)
+	}
+
+ddl_symbol
+	: ddl_type_symbol
+	| ddl_col_name_keyword
+
+ddl_type_symbol
+	: tokenString
 	| tokenPgSymbol
+	| ddl_unreserved_keyword
+
+ddl_unreserved_keyword
+	: tokenIF
+	| tokenNULLS
+	| tokenOF
+	| tokenKEY
+	| tokenDOUBLE
+	| tokenVARYING
+	| tokenWITHOUT
+	| tokenZONE
+	| tokenYEAR
+	| tokenMONTH
+	| tokenDAY
+	| tokenHOUR
+	| tokenMINUTE
+	| tokenSECOND
+	| tokenUNKNOWN
+	| tokenMATCH
+	| tokenPARTIAL
+	| tokenSIMPLE
+	| tokenDELETE
+	| tokenUPDATE
+	| tokenCASCADE
+	| tokenRESTRICT
+	| tokenSET
+	| tokenNO
+	| tokenACTION
+	| tokenDEFERRED
+	| tokenIMMEDIATE
+	| tokenINHERIT
+	| tokenINCLUDE
+	| tokenFIRST
+	| tokenLAST
+	| tokenGENERATED
+	| tokenALWAYS
+	| tokenBY
+	| tokenIDENTITY
+	| tokenSTORED
+	| tokenVIRTUAL
+	| tokenSTART
+	| tokenINCREMENT
+	| tokenMINVALUE
+	| tokenMAXVALUE
+	| tokenCACHE
+	| tokenCYCLE
+	| tokenSEQUENCE
+	| tokenNAME
+	| tokenINDEX
+	| tokenTABLESPACE
+	| tokenTEMP
+	| tokenTEMPORARY
+	| tokenUNLOGGED
+	| tokenGLOBAL
+	| tokenLOCAL
+	| tokenCOMMIT
+	| tokenPRESERVE
+	| tokenROWS
+	| tokenDROP
+	| tokenPARTITION
+	| tokenOPTIONS
+	| tokenINHERITS
+	| tokenINCLUDING
+	| tokenEXCLUDING
+	| tokenOIDS
+	| tokenTYPE
+	| tokenMATERIALIZED
+	| tokenVIEW
+	| tokenDATA
+	| tokenSERVER
+
+ddl_col_name_keyword
+	: tokenEXISTS
+	| tokenPRECISION
+	| tokenCHARACTER
+	| tokenCHAR
+	| tokenNATIONAL
+	| tokenNCHAR
+	| tokenBIT
+	| tokenTIMESTAMP
+	| tokenTIME
+	| tokenINTERVAL
+	| tokenBETWEEN
+	| tokenROW
+	| tokenVALUES
+
 ddl_value
 	: tokenString
 	| tokenPgValue
@@ -1,8 +1,10 @@
 package tableParser
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -38,6 +40,14 @@ func defineEqual(d1, d2 *TableDefine) (bool, error) {
 		if !reflect.DeepEqual(d1.Constraint.PrimaryKey, d2.Constraint.PrimaryKey) {
 			return false, fmt.Errorf("Primary key is not equal")
 		}
+		if len(d1.Constraint.Uniques) != len(d2.Constraint.Uniques) {
+			return false, fmt.Errorf("unique num is not equal")
+		}
+		for index, unique := range d1.Constraint.Uniques {
+			if !reflect.DeepEqual(unique, d2.Constraint.Uniques[index]) {
+				return false, fmt.Errorf("%d unique is not equal", index)
+			}
+		}
 	} else if d2.Constraint != nil {
 		return false, fmt.Errorf("Constraint is not equal")
 	}
@@ -47,7 +57,7 @@ func defineEqual(d1, d2 *TableDefine) (bool, error) {
 func makeDefine(schema, table string, columns [][]string, constraint *TableConstraint) *TableDefine {
 	cols := []*TableColumn{}
 	for _, column := range columns {
-		cols = append(cols, &TableColumn{column[0], column[1], false}) // nullable false
+		cols = append(cols, &TableColumn{Name: column[0], Type: column[1]}) // nullable false
 	}
 	return &TableDefine{
 		Schema:     schema,
@@ -81,6 +91,52 @@ const (
     "id"  SERIAL,
 	"age" INTEGER NOT NULL DEFAULT 0
 );
+`
+	typeModifierCreate = `CREATE TABLE typeModifier (
+    "name" VARCHAR(255) NOT NULL,
+    "price" NUMERIC(12, 4),
+    "amount" pg_catalog.numeric(10),
+    "at" TIME(3)
+);
+`
+	arrayCreate = `CREATE TABLE arrayType (
+    "tags" TEXT[],
+    "matrix" INT[][],
+    "scores" NUMERIC(5,2)[3],
+    "slots" INT ARRAY[4],
+    "flags" BOOLEAN ARRAY
+);
+`
+	multiWordTypeCreate = `CREATE TABLE multiWordType (
+    "created_at" timestamp without time zone,
+    "updated_at" TIMESTAMP(3) WITH TIME ZONE,
+    "ratio" double precision,
+    "code" character varying(64),
+    "mask" bit varying,
+    "elapsed" interval day to second(3),
+    time time,
+    day timestamptz
+);
+`
+	commentCreate = `/*
+ * users table /* nested */
+ */
+CREATE TABLE comment ( -- columns
+    "id" SERIAL PRIMARY KEY, -- added for ticket
+    /* display name */ "name" TEXT,
+    -- user's email
+    -- must be unique
+    "email" TEXT UNIQUE,
+    -- not attached to anything
+
+    "age" INTEGER -- in years
+); -- users of the system
+-- next statement`
+	compositeKeyCreate = `CREATE TABLE compositeKey (
+    "tenant_id" INTEGER NOT NULL,
+    "id" INTEGER NOT NULL,
+    PRIMARY KEY ("tenant_id","id")
+);
 `
 )
 
@@ -92,8 +148,8 @@ var parserTests = []parseTest{
 		{"created_at", "TIMESTAMPTZ"},
 		{"info", "JSONB"},
 	}, &TableConstraint{
-		PrimaryKey: []string{"id"},
-		Uniques:    [][]string{[]string{"uuid"}},
+		PrimaryKey: &PrimaryKey{Name: "users_pkey", Columns: []string{"id"}},
+		Uniques:    []*Unique{{Name: "users_uuid_key", Columns: []string{"uuid"}}},
 	}),
 	},
 	{"constant", constantCreate, makeDefine("admin", "users2", [][]string{
@@ -103,8 +159,8 @@ var parserTests = []parseTest{
 		{"trait", "TEXT"},
 		{"extra", "JSONB"},
 	}, &TableConstraint{
-		PrimaryKey: []string{"id"},
-		Uniques:    [][]string{[]string{"type", "trait"}},
+		PrimaryKey: &PrimaryKey{Name: "users2_pkey", Columns: []string{"id"}},
+		Uniques:    []*Unique{{Name: "users2_type_trait_key", Columns: []string{"type", "trait"}}},
 	}),
 	},
 	{
@@ -119,6 +175,54 @@ var parserTests = []parseTest{
 			{"age", "INTEGER"},
 		}, &TableConstraint{}),
 	},
+	{
+		"typeModifierCreate", typeModifierCreate, makeDefine("", "typeModifier", [][]string{
+			{"name", "VARCHAR(255)"},
+			{"price", "NUMERIC(12,4)"},
+			{"amount", "pg_catalog.numeric(10)"},
+			{"at", "TIME(3)"},
+		}, &TableConstraint{}),
+	},
+	{
+		"arrayCreate", arrayCreate, makeDefine("", "arrayType", [][]string{
+			{"tags", "TEXT[]"},
+			{"matrix", "INT[][]"},
+			{"scores", "NUMERIC(5,2)[3]"},
+			{"slots", "INT[4]"},
+			{"flags", "BOOLEAN[]"},
+		}, &TableConstraint{}),
+	},
+	{
+		"multiWordTypeCreate", multiWordTypeCreate, makeDefine("", "multiWordType", [][]string{
+			{"created_at", "timestamp without time zone"},
+			{"updated_at", "TIMESTAMP(3) WITH TIME ZONE"},
+			{"ratio", "double precision"},
+			{"code", "character varying(64)"},
+			{"mask", "bit varying"},
+			{"elapsed", "interval day to second(3)"},
+			{"time", "time"},
+			{"day", "timestamptz"},
+		}, &TableConstraint{}),
+	},
+	{
+		"commentCreate", commentCreate, makeDefine("", "comment", [][]string{
+			{"id", "SERIAL"},
+			{"name", "TEXT"},
+			{"email", "TEXT"},
+			{"age", "INTEGER"},
+		}, &TableConstraint{
+			PrimaryKey: &PrimaryKey{Name: "comment_pkey", Columns: []string{"id"}},
+			Uniques:    []*Unique{{Name: "comment_email_key", Columns: []string{"email"}}},
+		}),
+	},
+	{
+		"compositeKeyCreate", compositeKeyCreate, makeDefine("", "compositeKey", [][]string{
+			{"tenant_id", "INTEGER"},
+			{"id", "INTEGER"},
+		}, &TableConstraint{
+			PrimaryKey: &PrimaryKey{Name: "compositeKey_pkey", Columns: []string{"tenant_id", "id"}},
+		}),
+	},
 }
 
 func TestParser(t *testing.T) {
@@ -145,3 +249,1226 @@ func TestParser(t *testing.T) {
 
 	}
 }
+
+func TestDataTypeModifiers(t *testing.T) {
+	defs, err := ParseTable("typeModifier", typeModifierCreate)
+	if err != nil {
+		t.Fatalf("parse typeModifier err :%s", err)
+	}
+	columns := defs[0].Columns
+	if length, ok := columns[0].DataType.Length(); !ok || length != 255 {
+		t.Errorf("name length got %d %v, expect 255", length, ok)
+	}
+	if precision, ok := columns[1].DataType.Precision(); !ok || precision != 12 {
+		t.Errorf("price precision got %d %v, expect 12", precision, ok)
+	}
+	if scale, ok := columns[1].DataType.Scale(); !ok || scale != 4 {
+		t.Errorf("price scale got %d %v, expect 4", scale, ok)
+	}
+	if scale, ok := columns[2].DataType.Scale(); !ok || scale != 0 {
+		t.Errorf("amount scale got %d %v, expect 0", scale, ok)
+	}
+	if precision, ok := columns[3].DataType.Precision(); !ok || precision != 3 {
+		t.Errorf("at precision got %d %v, expect 3", precision, ok)
+	}
+	if _, ok := columns[3].DataType.Length(); ok {
+		t.Errorf("at should not have a length")
+	}
+}
+
+func TestDataTypeArray(t *testing.T) {
+	defs, err := ParseTable("arrayType", arrayCreate)
+	if err != nil {
+		t.Fatalf("parse arrayType err :%s", err)
+	}
+	expects := [][]int{{-1}, {-1, -1}, {3}, {4}, {-1}}
+	for index, column := range defs[0].Columns {
+		if !column.DataType.IsArray() {
+			t.Errorf("column %s should be an array", column.Name)
+		}
+		if !reflect.DeepEqual(column.DataType.ArrayDims, expects[index]) {
+			t.Errorf("column %s array dims got %v, expect %v", column.Name, column.DataType.ArrayDims, expects[index])
+		}
+	}
+	if elem := defs[0].Columns[2].DataType.ElemType(); elem.String() != "NUMERIC(5,2)" {
+		t.Errorf("scores element type got %s, expect NUMERIC(5,2)", elem)
+	}
+}
+
+func TestDataTypeCanonical(t *testing.T) {
+	defs, err := ParseTable("multiWordType", multiWordTypeCreate)
+	if err != nil {
+		t.Fatalf("parse multiWordType err :%s", err)
+	}
+	expects := []string{
+		"timestamp without time zone",
+		"timestamp(3) with time zone",
+		"double precision",
+		"character varying(64)",
+		"bit varying",
+		"interval day to second(3)",
+		"time without time zone",
+		"timestamp with time zone",
+	}
+	for index, column := range defs[0].Columns {
+		if column.CanonicalType != expects[index] {
+			t.Errorf("column %s canonical type got %s, expect %s", column.Name, column.CanonicalType, expects[index])
+		}
+	}
+	columns := defs[0].Columns
+	if columns[1].DataType.CanonicalName() != columns[7].DataType.CanonicalName() {
+		t.Errorf("TIMESTAMP WITH TIME ZONE and timestamptz should have the same canonical name")
+	}
+	if length, ok := columns[3].DataType.Length(); !ok || length != 64 {
+		t.Errorf("code length got %d %v, expect 64", length, ok)
+	}
+	for _, typ := range []*DataType{
+		{Name: "int4"},
+		{Name: "INTEGER"},
+		{Schema: "pg_catalog", Name: "int"},
+	} {
+		if typ.CanonicalName() != "integer" {
+			t.Errorf("%s canonical name got %s, expect integer", typ, typ.CanonicalName())
+		}
+	}
+	if typ := (&DataType{Name: "float", Modifiers: []string{"24"}}); typ.Canonical() != "real" {
+		t.Errorf("float(24) canonical got %s, expect real", typ.Canonical())
+	}
+
+	// a character or bit type without a length is one long, except bpchar and the quoted "char"
+	defs, err = ParseTable("charType", `CREATE TABLE t (a char, b CHARACTER(1), c nchar, d bit, e bpchar, f "char", g pg_catalog.char, h char[])`)
+	if err != nil {
+		t.Fatalf("parse char types err :%s", err)
+	}
+	expects = []string{"character(1)", "character(1)", "character(1)", "bit(1)", "character", `"char"`, `"char"`, "character(1)[]"}
+	lengths := []int{1, 1, 1, 1, 0, 0, 0, 1}
+	for index, column := range defs[0].Columns {
+		if column.CanonicalType != expects[index] {
+			t.Errorf("column %s canonical type got %s, expect %s", column.Name, column.CanonicalType, expects[index])
+		}
+		if length, _ := column.DataType.Length(); length != lengths[index] {
+			t.Errorf("column %s length got %d, expect %d", column.Name, length, lengths[index])
+		}
+	}
+}
+
+func TestComment(t *testing.T) {
+	defs, err := ParseTable("comment", commentCreate)
+	if err != nil {
+		t.Fatalf("parse comment err :%s", err)
+	}
+	def := defs[0]
+	if expect := "users table /* nested */\nusers of the system"; def.Comment != expect {
+		t.Errorf("table comment got %q, expect %q", def.Comment, expect)
+	}
+	expects := []string{"added for ticket", "display name", "user's email\nmust be unique", "in years"}
+	for index, column := range def.Columns {
+		if column.Comment != expects[index] {
+			t.Errorf("column %s comment got %q, expect %q", column.Name, column.Comment, expects[index])
+		}
+	}
+}
+
+const defaultCreate = `CREATE TABLE "default" (
+    "id" INTEGER NOT NULL DEFAULT nextval('default_id_seq'::regclass),
+    "info" JSONB DEFAULT '{}'::jsonb NOT NULL,
+    "age" INTEGER DEFAULT 0,
+    "active" BOOLEAN DEFAULT TRUE,
+    "note" TEXT DEFAULT NULL,
+    "created_at" TIMESTAMPTZ DEFAULT now(),
+    "code" TEXT DEFAULT public.make_code(8, 'x'),
+    "name" TEXT
+)`
+
+func TestColumnDefault(t *testing.T) {
+	defs, err := ParseTable("default", defaultCreate)
+	if err != nil {
+		t.Fatalf("parse default err :%s", err)
+	}
+	expects := []struct {
+		text string
+		expr string
+	}{
+		{"nextval('default_id_seq'::regclass)", "nextval('default_id_seq'::regclass)"},
+		{"'{}'::jsonb", "'{}'::jsonb"},
+		{"0", "0"},
+		{"TRUE", "TRUE"},
+		{"NULL", "NULL"},
+		{"now()", "now()"},
+		{"public.make_code(8, 'x')", "public.make_code(8, 'x')"},
+		{"", ""},
+	}
+	for index, column := range defs[0].Columns {
+		if column.Default != expects[index].text {
+			t.Errorf("column %s default got %q, expect %q", column.Name, column.Default, expects[index].text)
+		}
+		if column.DefaultExpr == nil {
+			if expects[index].expr != "" {
+				t.Errorf("column %s default expression is missing", column.Name)
+			}
+			continue
+		}
+		if column.DefaultExpr.String() != expects[index].expr {
+			t.Errorf("column %s default expression got %q, expect %q", column.Name, column.DefaultExpr, expects[index].expr)
+		}
+	}
+	if seq, ok := defs[0].Columns[0].Sequence(); !ok || seq != "default_id_seq" {
+		t.Errorf("id sequence got %q %v, expect default_id_seq", seq, ok)
+	}
+	if _, ok := defs[0].Columns[5].Sequence(); ok {
+		t.Errorf("created_at should not have a sequence")
+	}
+	if call, ok := defs[0].Columns[6].DefaultExpr.(*FuncCall); !ok || call.Schema != "public" || len(call.Args) != 2 {
+		t.Errorf("code default should be a function call with 2 args, got %#v", defs[0].Columns[6].DefaultExpr)
+	}
+}
+
+func TestDefaultExpression(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"now() + interval '1 day'", "now() + '1 day'::interval"},
+		{"-1", "-1"},
+		{"- 2 * (3 + 4)", "-2 * (3 + 4)"},
+		{"- -1", "- -1"},
+		{"-(-a)", "-(-a)"},
+		{"- - a", "- -a"},
+		{"@ -1", "@ -1"},
+		{"1 + 2 * 3 ^ 2", "1 + 2 * 3 ^ 2"},
+		{"ARRAY[]::text[]", "ARRAY[]::text[]"},
+		{"ARRAY[[1, 2], [3, 4]]", "ARRAY[[1, 2], [3, 4]]"},
+		{"CAST('1' AS INTEGER)", "'1'::INTEGER"},
+		{"CAST(a + 1 AS bigint)", "(a + 1)::bigint"},
+		{"ROW(1, 'a')", "ROW(1, 'a')"},
+		{"(1, 'a')", "(1, 'a')"},
+		{"CASE WHEN a IS NULL THEN 0 WHEN a IN (1, 2) THEN 1 ELSE 2 END", "CASE WHEN a IS NULL THEN 0 WHEN a IN (1, 2) THEN 1 ELSE 2 END"},
+		{"CASE kind WHEN 'a' THEN 1 END", "CASE kind WHEN 'a' THEN 1 END"},
+		{"(a IS NOT NULL AND NOT b OR c NOT IN (1) AND d BETWEEN 1 AND 2)", "(a IS NOT NULL AND NOT b OR c NOT IN (1) AND d BETWEEN 1 AND 2)"},
+		{"(name NOT LIKE 'a%' AND code ILIKE 'b' AND x IS DISTINCT FROM y)", "(name NOT LIKE 'a%' AND code ILIKE 'b' AND x IS DISTINCT FROM y)"},
+		{"timestamp with time zone '2020-01-01'", "'2020-01-01'::timestamp with time zone"},
+		{"pg_catalog.f(a.b, 1.5)::numeric(10,2)", "pg_catalog.f(a.b, 1.5)::numeric(10,2)"},
+	}
+	for _, test := range tests {
+		input := fmt.Sprintf("CREATE TABLE t (c INT DEFAULT %s NOT NULL)", test.input)
+		defs, err := ParseTable(test.input, input)
+		if err != nil {
+			t.Errorf("parse %s err :%s", test.input, err)
+			continue
+		}
+		column := defs[0].Columns[0]
+		if column.Default != test.input {
+			t.Errorf("default text got %q, expect %q", column.Default, test.input)
+		}
+		if column.DefaultExpr.String() != test.expect {
+			t.Errorf("default expression of %s got %q, expect %q", test.input, column.DefaultExpr, test.expect)
+		}
+		if column.Nullable {
+			t.Errorf("column of %s should not be nullable", test.input)
+		}
+	}
+}
+
+func TestSQLValueFunc(t *testing.T) {
+	defs, err := ParseTable("sqlValueFunc", `CREATE TABLE t (
+	created_at timestamp DEFAULT CURRENT_TIMESTAMP,
+	owner name DEFAULT current_user,
+	d date CHECK (d <= current_date),
+	"current_date" date DEFAULT "current_date"
+)`)
+	if err != nil {
+		t.Fatalf("parse sql value function err :%s", err)
+	}
+	columns := defs[0].Columns
+	for index, expect := range []string{"CURRENT_TIMESTAMP", "CURRENT_USER"} {
+		if f, ok := columns[index].DefaultExpr.(*SQLValueFunc); !ok || f.Name != expect {
+			t.Errorf("column %s default should be %s, got %#v", columns[index].Name, expect, columns[index].DefaultExpr)
+		}
+	}
+	if ref, ok := columns[3].DefaultExpr.(*ColumnRef); !ok || ref.String() != "current_date" {
+		t.Errorf("quoted current_date should be a column reference, got %#v", columns[3].DefaultExpr)
+	}
+	if check := defs[0].Constraint.Checks[0]; check.Name != "t_d_check" || check.Expr.String() != "d <= CURRENT_DATE" {
+		t.Errorf("check got %s %q, expect t_d_check", check.Name, check.Expr)
+	}
+}
+
+const foreignKeyCreate = `CREATE TABLE orders (
+	id INT PRIMARY KEY,
+	user_id INT NOT NULL REFERENCES users ON DELETE CASCADE,
+	product_id INT REFERENCES shop.products (id) MATCH FULL ON UPDATE SET NULL ON DELETE RESTRICT DEFERRABLE INITIALLY DEFERRED,
+	a INT,
+	b INT,
+	FOREIGN KEY (a, b) REFERENCES pairs (x, y) ON DELETE SET DEFAULT NOT DEFERRABLE
+)`
+
+func TestForeignKey(t *testing.T) {
+	defs, err := ParseTable("foreignKey", foreignKeyCreate)
+	if err != nil {
+		t.Fatalf("parse foreign key err :%s", err)
+	}
+	expects := []*ForeignKey{
+		{
+			Name: "orders_user_id_fkey", Columns: []string{"user_id"}, RefTable: "users",
+			Match: MatchSimple, OnDelete: ActionCascade, OnUpdate: ActionNoAction,
+		},
+		{
+			Name: "orders_product_id_fkey", Columns: []string{"product_id"}, RefSchema: "shop", RefTable: "products", RefColumns: []string{"id"},
+			Match: MatchFull, OnDelete: ActionRestrict, OnUpdate: ActionSetNull,
+			Deferrability: Deferrability{Deferrable: true, InitiallyDeferred: true},
+		},
+		{
+			Name: "orders_a_b_fkey", Columns: []string{"a", "b"}, RefTable: "pairs", RefColumns: []string{"x", "y"},
+			Match: MatchSimple, OnDelete: ActionSetDefault, OnUpdate: ActionNoAction,
+		},
+	}
+	fks := defs[0].Constraint.ForeignKeys
+	if len(fks) != len(expects) {
+		t.Fatalf("foreign key num got %d, expect %d", len(fks), len(expects))
+	}
+	for index, fk := range fks {
+		if !reflect.DeepEqual(fk, expects[index]) {
+			t.Errorf("foreign key %d got %s, expect %s", index, fk, expects[index])
+		}
+	}
+	if defs[0].Columns[1].Nullable {
+		t.Errorf("user_id should not be nullable")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	input := `CREATE TABLE product (
+	age INT CHECK (age >= 0) NOT NULL,
+	price NUMERIC CHECK ( price > 0 AND price < 1000 ) NO INHERIT,
+	CHECK (price IS NOT NULL OR age BETWEEN 1 AND 10)
+)`
+	defs, err := ParseTable("check", input)
+	if err != nil {
+		t.Fatalf("parse check err :%s", err)
+	}
+	expects := []struct {
+		source    string
+		expr      string
+		noInherit bool
+	}{
+		{"age >= 0", "age >= 0", false},
+		{"price > 0 AND price < 1000", "price > 0 AND price < 1000", true},
+		{"price IS NOT NULL OR age BETWEEN 1 AND 10", "price IS NOT NULL OR age BETWEEN 1 AND 10", false},
+	}
+	checks := defs[0].Constraint.Checks
+	if len(checks) != len(expects) {
+		t.Fatalf("check num got %d, expect %d", len(checks), len(expects))
+	}
+	for index, check := range checks {
+		if check.Source != expects[index].source {
+			t.Errorf("check %d source got %q, expect %q", index, check.Source, expects[index].source)
+		}
+		if check.Expr.String() != expects[index].expr {
+			t.Errorf("check %d expression got %q, expect %q", index, check.Expr, expects[index].expr)
+		}
+		if check.NoInherit != expects[index].noInherit {
+			t.Errorf("check %d no inherit got %v", index, check.NoInherit)
+		}
+	}
+	if defs[0].Columns[0].Nullable {
+		t.Errorf("age should not be nullable")
+	}
+}
+
+func TestConstraintName(t *testing.T) {
+	input := `CREATE TABLE users (
+	id INT CONSTRAINT users_id PRIMARY KEY,
+	email TEXT CONSTRAINT email_required NOT NULL,
+	age INT CHECK (age >= 0) CONSTRAINT age_limit CHECK (age < 200),
+	low INT,
+	high INT NOT NULL,
+	group_id INT CONSTRAINT users_group REFERENCES groups,
+	CONSTRAINT users_email_key UNIQUE (email),
+	UNIQUE (low, high),
+	CHECK (low < high),
+	CHECK (low > 0 OR high > 0)
+)`
+	defs, err := ParseTable("constraintName", input)
+	if err != nil {
+		t.Fatalf("parse constraint name err :%s", err)
+	}
+	c := defs[0].Constraint
+	names := []string{c.PrimaryKey.Name}
+	for _, unique := range c.Uniques {
+		names = append(names, unique.Name)
+	}
+	for _, fk := range c.ForeignKeys {
+		names = append(names, fk.Name)
+	}
+	for _, check := range c.Checks {
+		names = append(names, check.Name)
+	}
+	for _, notNull := range c.NotNulls {
+		names = append(names, notNull.Name)
+	}
+	expects := []string{
+		"users_id",
+		"users_email_key", "users_low_high_key",
+		"users_group",
+		"users_age_check", "age_limit", "users_check", "users_check1",
+		"email_required", "users_high_not_null",
+	}
+	if !reflect.DeepEqual(names, expects) {
+		t.Errorf("constraint names got %v, expect %v", names, expects)
+	}
+
+	// a column constraint declared before a table constraint gets the name first
+	defs, err = ParseTable("constraintName", `CREATE TABLE t (
+	a INT CHECK (a > 0) REFERENCES x,
+	CHECK (a < 10),
+	FOREIGN KEY (a) REFERENCES y
+)`)
+	if err != nil {
+		t.Fatalf("parse constraint name clash err :%s", err)
+	}
+	c = defs[0].Constraint
+	if c.Checks[0].Source != "a > 0" || c.Checks[0].Name != "t_a_check" || c.Checks[1].Name != "t_a_check1" {
+		t.Errorf("clashing check names got %s %s, %s %s", c.Checks[0].Source, c.Checks[0].Name, c.Checks[1].Source, c.Checks[1].Name)
+	}
+	if c.ForeignKeys[0].RefTable != "x" || c.ForeignKeys[0].Name != "t_a_fkey" || c.ForeignKeys[1].Name != "t_a_fkey1" {
+		t.Errorf("clashing foreign key names got %s %s, %s %s", c.ForeignKeys[0].RefTable, c.ForeignKeys[0].Name, c.ForeignKeys[1].RefTable, c.ForeignKeys[1].Name)
+	}
+}
+
+func TestMakeObjectName(t *testing.T) {
+	tests := []struct {
+		name1, name2, label string
+		expect              string
+	}{
+		{"users", "", "pkey", "users_pkey"},
+		{"users", "a_b", "key", "users_a_b_key"},
+		{strings.Repeat("t", 40), strings.Repeat("c", 40), "fkey", strings.Repeat("t", 29) + "_" + strings.Repeat("c", 28) + "_fkey"},
+		{strings.Repeat("t", 70), "", "pkey", strings.Repeat("t", 58) + "_pkey"},
+	}
+	for _, test := range tests {
+		if name := makeObjectName(test.name1, test.name2, test.label); name != test.expect {
+			t.Errorf("object name of %s %s %s got %s, expect %s", test.name1, test.name2, test.label, name, test.expect)
+		}
+	}
+}
+
+func TestExclusion(t *testing.T) {
+	input := `CREATE TABLE booking (
+	room_id INT,
+	during TSRANGE,
+	canceled BOOLEAN,
+	EXCLUDE USING gist (room_id WITH =, during WITH &&),
+	CONSTRAINT no_overlap EXCLUDE USING gist (room_id gist_int4_ops DESC NULLS LAST WITH =, lower(during) WITH <>)
+		INCLUDE (canceled) WITH (fillfactor = 70, Buffering) WHERE (NOT canceled)
+)`
+	defs, err := ParseTable("exclusion", input)
+	if err != nil {
+		t.Fatalf("parse exclusion err :%s", err)
+	}
+	exclusions := defs[0].Constraint.Exclusions
+	expects := []struct {
+		name string
+		text string
+	}{
+		{"booking_room_id_during_excl", "EXCLUDE USING gist (room_id WITH =, during WITH &&)"},
+		{"no_overlap", "EXCLUDE USING gist (room_id gist_int4_ops DESC NULLS LAST WITH =, lower(during) WITH <>) INCLUDE (canceled) WITH (buffering, fillfactor=70) WHERE (NOT canceled)"},
+	}
+	if len(exclusions) != len(expects) {
+		t.Fatalf("exclusion num got %d, expect %d", len(exclusions), len(expects))
+	}
+	for index, exclusion := range exclusions {
+		if exclusion.Name != expects[index].name {
+			t.Errorf("exclusion %d name got %s, expect %s", index, exclusion.Name, expects[index].name)
+		}
+		if exclusion.String() != expects[index].text {
+			t.Errorf("exclusion %d got %s, expect %s", index, exclusion, expects[index].text)
+		}
+	}
+	if exclusions[1].WhereSource != "NOT canceled" || exclusions[1].With["fillfactor"] != "70" {
+		t.Errorf("exclusion where %q with %v", exclusions[1].WhereSource, exclusions[1].With)
+	}
+}
+
+func TestGeneratedColumn(t *testing.T) {
+	input := `CREATE TABLE invoice (
+	id bigint GENERATED ALWAYS AS IDENTITY (START WITH 100 INCREMENT BY 10 NO MAXVALUE CACHE 5 CYCLE),
+	no int GENERATED BY DEFAULT AS IDENTITY,
+	price numeric NOT NULL,
+	qty int,
+	total numeric GENERATED ALWAYS AS (price * qty) STORED
+)`
+	defs, err := ParseTable("generated", input)
+	if err != nil {
+		t.Fatalf("parse generated err :%s", err)
+	}
+	columns := defs[0].Columns
+	id := columns[0].Identity
+	if id == nil || !id.Always || *id.Sequence.Start != 100 || *id.Sequence.Increment != 10 ||
+		*id.Sequence.Cache != 5 || !id.Sequence.Cycle || id.Sequence.MaxValue != nil {
+		t.Errorf("id identity got %#v", id)
+	}
+	if no := columns[1].Identity; no == nil || no.Always || no.Sequence.Start != nil {
+		t.Errorf("no identity got %#v", no)
+	}
+	if columns[4].Generated != "price * qty" || !columns[4].GeneratedStored {
+		t.Errorf("total generated got %q stored %v", columns[4].Generated, columns[4].GeneratedStored)
+	}
+	insertable := []bool{false, true, true, true, false}
+	for index, column := range columns {
+		if column.Insertable() != insertable[index] {
+			t.Errorf("column %s insertable got %v", column.Name, column.Insertable())
+		}
+	}
+	if columns[0].Nullable || columns[1].Nullable {
+		t.Errorf("identity columns should not be nullable")
+	}
+}
+
+func TestNullConstraint(t *testing.T) {
+	defs, err := ParseTable("null", "CREATE TABLE t (a INT NULL, b TEXT DEFAULT NULL NULL, c INT NOT NULL)")
+	if err != nil {
+		t.Fatalf("parse null err :%s", err)
+	}
+	nullable := []bool{true, true, false}
+	for index, column := range defs[0].Columns {
+		if column.Nullable != nullable[index] {
+			t.Errorf("column %s nullable got %v", column.Name, column.Nullable)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	input := `CREATE TABLE account (
+	id INT PRIMARY KEY WITH (fillfactor=90) USING INDEX TABLESPACE fast,
+	email TEXT NOT NULL UNIQUE,
+	phone TEXT UNIQUE NULLS NOT DISTINCT,
+	tenant_id INT,
+	code TEXT,
+	UNIQUE NULLS DISTINCT (tenant_id, code) INCLUDE (email) WITH (fillfactor = 70) USING INDEX TABLESPACE fast
+)`
+	defs, err := ParseTable("unique", input)
+	if err != nil {
+		t.Fatalf("parse unique err :%s", err)
+	}
+	c := defs[0].Constraint
+	expectPK := &PrimaryKey{
+		Name: "account_pkey", Columns: []string{"id"},
+		IndexParameters: IndexParameters{With: StorageParameters{"fillfactor": "90"}, Tablespace: "fast"},
+	}
+	if !reflect.DeepEqual(c.PrimaryKey, expectPK) {
+		t.Errorf("primary key got %#v", c.PrimaryKey)
+	}
+	expects := []*Unique{
+		{Name: "account_email_key", Columns: []string{"email"}},
+		{Name: "account_phone_key", Columns: []string{"phone"}, NullsNotDistinct: true},
+		{
+			Name: "account_tenant_id_code_key", Columns: []string{"tenant_id", "code"},
+			IndexParameters: IndexParameters{Include: []string{"email"}, With: StorageParameters{"fillfactor": "70"}, Tablespace: "fast"},
+		},
+	}
+	if len(c.Uniques) != len(expects) {
+		t.Fatalf("unique num got %d, expect %d", len(c.Uniques), len(expects))
+	}
+	for index, unique := range c.Uniques {
+		if !reflect.DeepEqual(unique, expects[index]) {
+			t.Errorf("unique %d got %#v, expect %#v", index, unique, expects[index])
+		}
+	}
+
+	// every UNIQUE of a column is a constraint of its own
+	defs, err = ParseTable("unique", "CREATE TABLE t (a INT UNIQUE UNIQUE)")
+	if err != nil {
+		t.Fatalf("parse repeated unique err :%s", err)
+	}
+	if uniques := defs[0].Constraint.Uniques; len(uniques) != 2 || uniques[0].Name != "t_a_key" || uniques[1].Name != "t_a_key1" {
+		t.Errorf("repeated unique got %v", uniques)
+	}
+}
+
+func TestDeferrable(t *testing.T) {
+	input := `CREATE TABLE task (
+	id INT PRIMARY KEY DEFERRABLE,
+	code TEXT UNIQUE INITIALLY DEFERRED,
+	parent_id INT REFERENCES task NOT DEFERRABLE INITIALLY IMMEDIATE,
+	a INT,
+	b INT,
+	UNIQUE (a, b) DEFERRABLE INITIALLY IMMEDIATE,
+	CHECK (a > b) NOT DEFERRABLE,
+	EXCLUDE (a WITH =) INITIALLY DEFERRED DEFERRABLE
+)`
+	defs, err := ParseTable("deferrable", input)
+	if err != nil {
+		t.Fatalf("parse deferrable err :%s", err)
+	}
+	c := defs[0].Constraint
+	tests := []struct {
+		name   string
+		got    Deferrability
+		expect Deferrability
+	}{
+		{"primary key", c.PrimaryKey.Deferrability, Deferrability{true, false}},
+		{"column unique", c.Uniques[0].Deferrability, Deferrability{true, true}},
+		{"table unique", c.Uniques[1].Deferrability, Deferrability{true, false}},
+		{"foreign key", c.ForeignKeys[0].Deferrability, Deferrability{false, false}},
+		{"exclusion", c.Exclusions[0].Deferrability, Deferrability{true, true}},
+	}
+	for _, test := range tests {
+		if test.got != test.expect {
+			t.Errorf("%s deferrability got %+v, expect %+v", test.name, test.got, test.expect)
+		}
+	}
+}
+
+func TestPersistence(t *testing.T) {
+	input := `CREATE TABLE IF NOT EXISTS plain (id INT);
+CREATE UNLOGGED TABLE cache (id INT);
+CREATE TEMP TABLE scratch (id INT) ON COMMIT DROP;
+CREATE GLOBAL TEMPORARY TABLE IF NOT EXISTS session_data (id INT) ON COMMIT PRESERVE ROWS;
+CREATE LOCAL TEMP TABLE batch (id INT) ON COMMIT DELETE ROWS;`
+	defs, err := ParseTable("persistence", input)
+	if err != nil {
+		t.Fatalf("parse persistence err :%s", err)
+	}
+	expects := []struct {
+		persistence Persistence
+		ifNotExists bool
+		onCommit    OnCommitAction
+	}{
+		{PersistencePermanent, true, OnCommitNone},
+		{PersistenceUnlogged, false, OnCommitNone},
+		{PersistenceTemporary, false, OnCommitDrop},
+		{PersistenceTemporary, true, OnCommitPreserveRows},
+		{PersistenceTemporary, false, OnCommitDeleteRows},
+	}
+	for index, def := range defs {
+		expect := expects[index]
+		if def.Persistence != expect.persistence || def.IfNotExists != expect.ifNotExists || def.OnCommit != expect.onCommit {
+			t.Errorf("table %s got %q %v %q, expect %+v", def.Table, def.Persistence, def.IfNotExists, def.OnCommit, expect)
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	input := `CREATE TABLE events (
+	id BIGINT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	kind TEXT
+) PARTITION BY RANGE (created_at);
+CREATE TABLE events_2026 PARTITION OF events FOR VALUES FROM ('2026-01-01') TO ('2027-01-01');
+CREATE TABLE events_old PARTITION OF events (
+	kind WITH OPTIONS NOT NULL,
+	CONSTRAINT events_old_pkey PRIMARY KEY (id)
+) FOR VALUES FROM (MINVALUE) TO ('2026-01-01');
+CREATE TABLE events_rest PARTITION OF events DEFAULT;
+CREATE TABLE orders (id INT, region TEXT) PARTITION BY LIST (lower(region));
+CREATE TABLE orders_eu PARTITION OF orders FOR VALUES IN ('de', 'fr') PARTITION BY HASH (id int4_ops);
+CREATE TABLE orders_eu_0 PARTITION OF orders_eu FOR VALUES WITH (MODULUS 4, REMAINDER 0);
+CREATE TABLE other_child PARTITION OF archive.events DEFAULT`
+	defs, err := ParseTable("partition", input)
+	if err != nil {
+		t.Fatalf("parse partition err :%s", err)
+	}
+	events := defs[0]
+	if events.PartitionBy == nil || events.PartitionBy.Strategy != PartitionRange || events.PartitionBy.Keys[0].Column != "created_at" {
+		t.Errorf("events partition by got %#v", events.PartitionBy)
+	}
+	if len(events.Partitions) != 3 {
+		t.Fatalf("events partition num got %d, expect 3", len(events.Partitions))
+	}
+	bounds := []string{
+		"FOR VALUES FROM ('2026-01-01') TO ('2027-01-01')",
+		"FOR VALUES FROM (MINVALUE) TO ('2026-01-01')",
+		"DEFAULT",
+	}
+	for index, partition := range events.Partitions {
+		if partition.Parent != events {
+			t.Errorf("partition %s is not linked to events", partition.Table)
+		}
+		if partition.PartitionOf.String() != bounds[index] {
+			t.Errorf("partition %s bound got %s, expect %s", partition.Table, partition.PartitionOf, bounds[index])
+		}
+	}
+	old := defs[2]
+	if len(old.Columns) != 1 || old.Columns[0].Name != "kind" || old.Columns[0].Nullable || old.Constraint.PrimaryKey.Name != "events_old_pkey" {
+		t.Errorf("events_old columns or constraint are not parsed")
+	}
+	if columns, err := old.EffectiveColumns(); err != nil || len(columns) != 3 || columns[2].Type != "TEXT" || columns[2].Nullable {
+		t.Errorf("events_old effective columns got %v err %v", columns, err)
+	}
+	orders, ordersEU := defs[4], defs[5]
+	if key := orders.PartitionBy.Keys[0]; orders.PartitionBy.Strategy != PartitionList || key.Source != "lower(region)" {
+		t.Errorf("orders partition key got %#v", key)
+	}
+	if ordersEU.Parent != orders || ordersEU.PartitionBy.Strategy != PartitionHash || ordersEU.PartitionBy.Keys[0].OpClass != "int4_ops" {
+		t.Errorf("orders_eu partition got %#v", ordersEU.PartitionBy)
+	}
+	if bound := defs[6].PartitionOf; defs[6].Parent != ordersEU || bound.Modulus != 4 || bound.Remainder != 0 {
+		t.Errorf("orders_eu_0 bound got %s", bound)
+	}
+	if other := defs[7]; other.Parent != nil || other.PartitionOf.ParentSchema != "archive" {
+		t.Errorf("other_child should not be linked to events")
+	}
+	if _, err := json.Marshal(defs); err != nil {
+		t.Errorf("linked partitions should be encoded to JSON, err :%s", err)
+	}
+
+	defs, err = ParseTable("partition", "CREATE TABLE p (a INT) PARTITION BY LIST (a); CREATE TABLE c PARTITION OF p (b WITH OPTIONS NOT NULL) DEFAULT")
+	if err != nil {
+		t.Fatalf("parse partition with unknown column err :%s", err)
+	}
+	if _, err := defs[1].EffectiveColumns(); err == nil {
+		t.Errorf("column b of the partition should not exist")
+	}
+}
+
+func TestNameCase(t *testing.T) {
+	defs, err := ParseTable("nameCase", `CREATE TABLE Events (id INT NOT NULL) PARTITION BY LIST (id);
+CREATE TABLE e1 PARTITION OF events FOR VALUES IN (1);
+CREATE TABLE Public.Base (a INT);
+CREATE TABLE child (b INT) INHERITS (public.BASE);
+CREATE TABLE copy (LIKE PUBLIC.base);
+CREATE TYPE Pair AS (x INT, y INT);
+CREATE TABLE pairs OF pair;
+CREATE TABLE "Quoted" (q INT);
+CREATE TABLE q1 PARTITION OF quoted DEFAULT;
+CREATE TABLE q2 PARTITION OF "Quoted" DEFAULT`)
+	if err != nil {
+		t.Fatalf("parse name case err :%s", err)
+	}
+	if defs[1].Parent != defs[0] {
+		t.Errorf("e1 should be a partition of Events")
+	}
+	if columns, err := defs[3].EffectiveColumns(); err != nil || len(columns) != 2 {
+		t.Errorf("child effective columns got %v err %v", columns, err)
+	}
+	if !defs[4].Likes[0].Expanded || len(defs[4].Columns) != 1 {
+		t.Errorf("LIKE PUBLIC.base should be expanded")
+	}
+	if !defs[5].TypeResolved || len(defs[5].Columns) != 2 {
+		t.Errorf("pairs should be resolved from type Pair")
+	}
+	if defs[7].Parent != nil || defs[8].Parent != defs[6] {
+		t.Errorf("a quoted name should only match the same quoted name")
+	}
+}
+
+func TestInherits(t *testing.T) {
+	input := `CREATE TABLE cities (name text NOT NULL, population real, elevation int DEFAULT 0);
+CREATE TABLE landmarks (name text, height int);
+CREATE TABLE capitals (state char(2), elevation int DEFAULT 10, population real NOT NULL) INHERITS (cities, landmarks);
+CREATE TABLE towns () INHERITS (cities);
+CREATE TABLE villages (name varchar) INHERITS (cities);
+CREATE TABLE ports (id int) INHERITS (public.harbors)`
+	defs, err := ParseTable("inherits", input)
+	if err != nil {
+		t.Fatalf("parse inherits err :%s", err)
+	}
+	capitals := defs[2]
+	if !reflect.DeepEqual(capitals.Inherits, []TableName{{"", "cities"}, {"", "landmarks"}}) {
+		t.Errorf("capitals inherits got %v", capitals.Inherits)
+	}
+	columns, err := capitals.EffectiveColumns()
+	if err != nil {
+		t.Fatalf("capitals effective columns err :%s", err)
+	}
+	expects := []struct {
+		name     string
+		nullable bool
+		def      string
+	}{
+		{"name", false, ""},
+		{"population", false, ""},
+		{"elevation", true, "10"},
+		{"height", true, ""},
+		{"state", true, ""},
+	}
+	if len(columns) != len(expects) {
+		t.Fatalf("capitals effective column num got %d, expect %d", len(columns), len(expects))
+	}
+	for index, column := range columns {
+		expect := expects[index]
+		if column.Name != expect.name || column.Nullable != expect.nullable || column.Default != expect.def {
+			t.Errorf("capitals column %d got %s %v %q, expect %+v", index, column.Name, column.Nullable, column.Default, expect)
+		}
+	}
+	if defs[0].Columns[1].Nullable != true || defs[0].Columns[2].Default != "0" {
+		t.Errorf("parent columns should not be changed")
+	}
+	if columns, err := defs[3].EffectiveColumns(); err != nil || len(columns) != 3 {
+		t.Errorf("towns effective columns got %d err %v", len(columns), err)
+	}
+	if _, err := defs[4].EffectiveColumns(); err == nil {
+		t.Errorf("villages should have a type conflict")
+	}
+	if _, err := defs[5].EffectiveColumns(); err == nil {
+		t.Errorf("ports should miss its parent")
+	}
+
+	// a parent column without a default does not conflict with one which has a default
+	for _, parents := range []string{"p1, p2", "p2, p1"} {
+		input := "CREATE TABLE p1 (a int DEFAULT 0); CREATE TABLE p2 (a int); CREATE TABLE c () INHERITS (" + parents + ")"
+		defs, err := ParseTable("inherits", input)
+		if err != nil {
+			t.Fatalf("parse inherits (%s) err :%s", parents, err)
+		}
+		columns, err := defs[2].EffectiveColumns()
+		if err != nil || len(columns) != 1 || columns[0].Default != "0" {
+			t.Errorf("inherits (%s) got %v err %v", parents, columns, err)
+		}
+	}
+	defs, err = ParseTable("inherits", "CREATE TABLE p1 (a int DEFAULT 0); CREATE TABLE p2 (a int DEFAULT 1); CREATE TABLE c () INHERITS (p1, p2)")
+	if err != nil {
+		t.Fatalf("parse conflicting defaults err :%s", err)
+	}
+	if _, err := defs[2].EffectiveColumns(); err == nil {
+		t.Errorf("conflicting inherited defaults should fail")
+	}
+}
+
+func TestLike(t *testing.T) {
+	input := `CREATE TABLE base (
+	id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	code text NOT NULL DEFAULT 'x' UNIQUE, -- the code
+	price int CHECK (price > 0)
+);
+CREATE TABLE copy (note text, LIKE base INCLUDING ALL EXCLUDING identity, extra int);
+CREATE TABLE plain (LIKE base);
+CREATE TABLE remote (LIKE other.base INCLUDING DEFAULTS)`
+	defs, err := ParseTable("like", input)
+	if err != nil {
+		t.Fatalf("parse like err :%s", err)
+	}
+	copied := defs[1]
+	like := copied.Likes[0]
+	if like.Source != (TableName{"", "base"}) || !like.Expanded || like.Has(LikeIdentity) || !like.Has(LikeDefaults|LikeIndexes) {
+		t.Errorf("copy like got %+v", like)
+	}
+	names := []string{}
+	for _, column := range copied.Columns {
+		names = append(names, column.Name)
+	}
+	if strings.Join(names, ",") != "note,id,code,price,extra" {
+		t.Errorf("copy columns got %v", names)
+	}
+	id, code := copied.Columns[1], copied.Columns[2]
+	if id.Identity != nil || id.Nullable || code.Default != "'x'" || code.Comment != "the code" {
+		t.Errorf("copy columns got id %+v code %+v", id, code)
+	}
+	c := copied.Constraint
+	if c.PrimaryKey == nil || c.PrimaryKey.Name != "copy_pkey" || len(c.Uniques) != 1 || c.Uniques[0].Name != "copy_code_key" {
+		t.Errorf("copy indexes got %+v", c)
+	}
+	if len(c.Checks) != 1 || c.Checks[0].Name != "base_price_check" {
+		t.Errorf("copy checks got %+v", c.Checks)
+	}
+	if len(c.NotNulls) != 2 || c.NotNulls[0].Name != "copy_id_not_null" || c.NotNulls[1].Name != "copy_code_not_null" {
+		t.Errorf("copy not nulls got %+v", c.NotNulls)
+	}
+
+	plain := defs[2]
+	if len(plain.Columns) != 3 || plain.Columns[0].Identity != nil || plain.Columns[1].Default != "" || plain.Columns[1].Comment != "" {
+		t.Errorf("plain columns got %+v", plain.Columns)
+	}
+	if plain.Constraint.PrimaryKey != nil || len(plain.Constraint.Uniques) != 0 || len(plain.Constraint.Checks) != 0 || len(plain.Constraint.NotNulls) != 2 {
+		t.Errorf("plain constraints got %+v", plain.Constraint)
+	}
+	if remote := defs[3]; remote.Likes[0].Expanded || len(remote.Columns) != 0 {
+		t.Errorf("remote like should not be expanded")
+	}
+
+	errInputs := []string{
+		"CREATE TABLE a (id int); CREATE TABLE b (id int, LIKE a)",
+		"CREATE TABLE a (id int PRIMARY KEY); CREATE TABLE b (k int PRIMARY KEY, LIKE a INCLUDING INDEXES)",
+		"CREATE TABLE b (LIKE a INCLUDING everything)",
+		"CREATE TABLE a () INHERITS (missing); CREATE TABLE b (LIKE a)",
+	}
+	for _, input := range errInputs {
+		if _, err := ParseTable("like", input); err == nil {
+			t.Errorf("%s should fail", input)
+		}
+	}
+}
+
+func TestStorageParameters(t *testing.T) {
+	input := `CREATE TABLE events (id int) WITH (fillfactor = 70, autovacuum_enabled = false, Toast.Autovacuum_Enabled, autovacuum_vacuum_scale_factor = 0.05) TABLESPACE fast_ssd;
+CREATE TABLE logs (id int) WITHOUT OIDS;
+CREATE TEMP TABLE scratch (id int) WITH (fillfactor = 'abc') ON COMMIT DROP TABLESPACE temp_space`
+	defs, err := ParseTable("storage", input)
+	if err != nil {
+		t.Fatalf("parse storage parameters err :%s", err)
+	}
+	events := defs[0]
+	if events.Tablespace != "fast_ssd" {
+		t.Errorf("events tablespace got %q", events.Tablespace)
+	}
+	if fillfactor, ok := events.With.Fillfactor(); !ok || fillfactor != 70 {
+		t.Errorf("events fillfactor got %d %v", fillfactor, ok)
+	}
+	if enabled, ok := events.With.AutovacuumEnabled(); !ok || enabled {
+		t.Errorf("events autovacuum_enabled got %v %v", enabled, ok)
+	}
+	if enabled, ok := events.With.Bool("toast.autovacuum_enabled"); !ok || !enabled {
+		t.Errorf("events toast.autovacuum_enabled got %v %v", enabled, ok)
+	}
+	if factor, ok := events.With.Float("autovacuum_vacuum_scale_factor"); !ok || factor != 0.05 {
+		t.Errorf("events autovacuum_vacuum_scale_factor got %v %v", factor, ok)
+	}
+	if logs := defs[1]; logs.With != nil || logs.Tablespace != "" {
+		t.Errorf("logs storage got %v %q", logs.With, logs.Tablespace)
+	}
+	if _, ok := defs[1].With.Fillfactor(); ok {
+		t.Errorf("logs should not have a fillfactor")
+	}
+	scratch := defs[2]
+	if _, ok := scratch.With.Fillfactor(); ok || scratch.OnCommit != OnCommitDrop || scratch.Tablespace != "temp_space" {
+		t.Errorf("scratch got %v %q %q", scratch.With, scratch.OnCommit, scratch.Tablespace)
+	}
+}
+
+func TestTypedTable(t *testing.T) {
+	input := `CREATE TYPE person_type AS (id int, name text COLLATE "C", email varchar(64));
+CREATE TABLE people OF person_type (
+	PRIMARY KEY (id),
+	name WITH OPTIONS NOT NULL, -- the name
+	email DEFAULT 'none'
+);
+CREATE TABLE strangers OF public.person_type (id WITH OPTIONS PRIMARY KEY);
+CREATE TABLE empty OF person_type`
+	defs, err := ParseTable("typed", input)
+	if err != nil {
+		t.Fatalf("parse typed table err :%s", err)
+	}
+	if len(defs) != 3 {
+		t.Fatalf("typed table num got %d, expect 3", len(defs))
+	}
+	people := defs[0]
+	if people.OfType == nil || *people.OfType != (TableName{"", "person_type"}) || !people.TypeResolved {
+		t.Errorf("people type got %v %v", people.OfType, people.TypeResolved)
+	}
+	expects := []struct {
+		name     string
+		typ      string
+		nullable bool
+		def      string
+	}{
+		{"id", "int", true, ""},
+		{"name", "text", false, ""},
+		{"email", "varchar(64)", true, "'none'"},
+	}
+	if len(people.Columns) != len(expects) {
+		t.Fatalf("people column num got %d, expect %d", len(people.Columns), len(expects))
+	}
+	for index, column := range people.Columns {
+		expect := expects[index]
+		if column.Name != expect.name || column.Type != expect.typ || column.Nullable != expect.nullable || column.Default != expect.def {
+			t.Errorf("people column %d got %s %s %v %q, expect %+v", index, column.Name, column.Type, column.Nullable, column.Default, expect)
+		}
+	}
+	if name := people.Columns[1]; name.Collation != "C" || name.Comment != "the name" {
+		t.Errorf("people name got collation %q comment %q", name.Collation, name.Comment)
+	}
+	if pk := people.Constraint.PrimaryKey; pk == nil || pk.Name != "people_pkey" {
+		t.Errorf("people primary key got %+v", pk)
+	}
+
+	strangers := defs[1]
+	if strangers.TypeResolved || len(strangers.Columns) != 1 || strangers.Columns[0].DataType != nil {
+		t.Errorf("strangers should not be resolved")
+	}
+	if pk := strangers.Constraint.PrimaryKey; pk == nil || pk.Columns[0] != "id" {
+		t.Errorf("strangers primary key got %+v", pk)
+	}
+	if len(defs[2].Columns) != 3 {
+		t.Errorf("empty column num got %d", len(defs[2].Columns))
+	}
+
+	errInputs := []string{
+		"CREATE TYPE t AS (a int); CREATE TABLE x OF t (b WITH OPTIONS NOT NULL)",
+		"CREATE TYPE t AS (a int); CREATE TABLE x OF t (a NOT NULL, a DEFAULT 1)",
+		"CREATE TABLE x OF t (a int)",
+	}
+	for _, input := range errInputs {
+		if _, err := ParseTable("typed", input); err == nil {
+			t.Errorf("%s should fail", input)
+		}
+	}
+}
+
+func TestTableQuery(t *testing.T) {
+	input := `SELECT pg_catalog.set_config('search_path', '', false);
+CREATE TABLE report_cache (region, total) AS
+	SELECT region, sum(amount) FROM orders GROUP BY region -- totals
+WITH NO DATA;
+CREATE TEMP TABLE IF NOT EXISTS recent ON COMMIT DROP AS SELECT * FROM orders WHERE created > now() - interval '1 day';
+CREATE MATERIALIZED VIEW IF NOT EXISTS stats.daily WITH (fillfactor = 50) AS (SELECT (a).x, ';' FROM t) WITH DATA;
+SELECT id, (SELECT 1 INTO y) INTO UNLOGGED TABLE public."Archive" FROM orders;
+CREATE TABLE after_query (id int)`
+	defs, err := ParseTable("query", input)
+	if err != nil {
+		t.Fatalf("parse table query err :%s", err)
+	}
+	if len(defs) != 5 {
+		t.Fatalf("table num got %d, expect 5", len(defs))
+	}
+	tests := []struct {
+		table       string
+		schema      string
+		persistence Persistence
+		query       TableQuery
+	}{
+		{"report_cache", "", PersistencePermanent, TableQuery{
+			Kind:    QueryCreateTableAs,
+			Columns: []string{"region", "total"},
+			Text:    "SELECT region, sum(amount) FROM orders GROUP BY region",
+			NoData:  true,
+		}},
+		{"recent", "", PersistenceTemporary, TableQuery{
+			Kind: QueryCreateTableAs,
+			Text: "SELECT * FROM orders WHERE created > now() - interval '1 day'",
+		}},
+		{"daily", "stats", PersistencePermanent, TableQuery{
+			Kind: QueryMaterializedView,
+			Text: "(SELECT (a).x, ';' FROM t)",
+		}},
+		{"Archive", "public", PersistenceUnlogged, TableQuery{
+			Kind: QuerySelectInto,
+			Text: `SELECT id, (SELECT 1 INTO y) INTO UNLOGGED TABLE public."Archive" FROM orders`,
+		}},
+	}
+	for index, test := range tests {
+		def := defs[index]
+		if def.Table != test.table || def.Schema != test.schema || def.Persistence != test.persistence {
+			t.Errorf("table %d got %s.%s %q, expect %+v", index, def.Schema, def.Table, def.Persistence, test)
+		}
+		if def.Query == nil || !reflect.DeepEqual(*def.Query, test.query) {
+			t.Errorf("table %s query got %+v, expect %+v", def.Table, def.Query, test.query)
+		}
+	}
+	if recent := defs[1]; !recent.IfNotExists || recent.OnCommit != OnCommitDrop {
+		t.Errorf("recent got if not exists %v on commit %q", recent.IfNotExists, recent.OnCommit)
+	}
+	if daily := defs[2]; !daily.IfNotExists || daily.With["fillfactor"] != "50" {
+		t.Errorf("daily got if not exists %v with %v", daily.IfNotExists, daily.With)
+	}
+	if after := defs[4]; after.Query != nil || len(after.Columns) != 1 {
+		t.Errorf("after_query got %+v", after)
+	}
+
+	input = `WITH recent AS (SELECT * FROM orders INTO y) SELECT * INTO TEMP data FROM recent;
+WITH gone AS (SELECT 1) INSERT INTO archive SELECT * FROM gone`
+	defs, err = ParseTable("query", input)
+	if err != nil {
+		t.Fatalf("parse query with WITH err :%s", err)
+	}
+	if len(defs) != 1 || defs[0].Table != "data" || defs[0].Persistence != PersistenceTemporary || !strings.HasPrefix(defs[0].Query.Text, "WITH recent") {
+		t.Errorf("select into after WITH got %d tables %+v", len(defs), defs)
+	}
+}
+
+func TestForeignTable(t *testing.T) {
+	input := `CREATE TABLE measurement (city_id int NOT NULL, logdate date NOT NULL) PARTITION BY RANGE (logdate);
+CREATE FOREIGN TABLE IF NOT EXISTS reporting.orders (
+	id bigint OPTIONS (column_name 'order_id') NOT NULL,
+	amount numeric(10, 2) CHECK (amount >= 0),
+	note text
+) SERVER reporting_srv OPTIONS (schema_name 'public', table_name 'orders', updatable 'false');
+CREATE FOREIGN TABLE measurement_y2016 PARTITION OF measurement FOR VALUES FROM ('2016-01-01') TO ('2017-01-01') SERVER archive`
+	defs, err := ParseTable("foreign", input)
+	if err != nil {
+		t.Fatalf("parse foreign table err :%s", err)
+	}
+	orders := defs[1]
+	if !orders.Foreign || !orders.IfNotExists || orders.Schema != "reporting" || orders.Server != "reporting_srv" {
+		t.Errorf("orders got foreign %v if not exists %v %s server %q", orders.Foreign, orders.IfNotExists, orders.Schema, orders.Server)
+	}
+	options := GenericOptions{"schema_name": "public", "table_name": "orders", "updatable": "false"}
+	if !reflect.DeepEqual(orders.Options, options) {
+		t.Errorf("orders options got %v, expect %v", orders.Options, options)
+	}
+	if id := orders.Columns[0]; !reflect.DeepEqual(id.Options, GenericOptions{"column_name": "order_id"}) || id.Nullable {
+		t.Errorf("orders id got options %v nullable %v", id.Options, id.Nullable)
+	}
+	if orders.Columns[1].Options != nil || len(orders.Constraint.Checks) != 1 {
+		t.Errorf("orders amount got options %v checks %d", orders.Columns[1].Options, len(orders.Constraint.Checks))
+	}
+	partition := defs[2]
+	if !partition.Foreign || partition.Server != "archive" || partition.Options != nil || partition.Parent != defs[0] {
+		t.Errorf("partition got foreign %v server %q options %v", partition.Foreign, partition.Server, partition.Options)
+	}
+	if defs[0].Foreign {
+		t.Errorf("measurement should not be foreign")
+	}
+	if text := options.String(); text != "schema_name 'public', table_name 'orders', updatable 'false'" {
+		t.Errorf("options string got %s", text)
+	}
+
+	defs, err = ParseTable("foreign", `CREATE FOREIGN TABLE t (a INT OPTIONS ("Column_Name" 'A', Other 'b')) SERVER s OPTIONS (Table_Name 'T')`)
+	if err != nil {
+		t.Fatalf("parse foreign table option names err :%s", err)
+	}
+	if options := defs[0].Columns[0].Options; !reflect.DeepEqual(options, GenericOptions{"Column_Name": "A", "other": "b"}) {
+		t.Errorf("quoted column option names got %v", options)
+	}
+	if options := defs[0].Options; !reflect.DeepEqual(options, GenericOptions{"table_name": "T"}) {
+		t.Errorf("table option names got %v", options)
+	}
+}
+
+func TestCollation(t *testing.T) {
+	input := `CREATE TABLE collation (
+	code text COLLATE "C" NOT NULL,
+	name character varying(64) COLLATE pg_catalog."default",
+	title text
+)`
+	defs, err := ParseTable("collation", input)
+	if err != nil {
+		t.Fatalf("parse collation err :%s", err)
+	}
+	expects := [][]string{{"", "C"}, {"pg_catalog", "default"}, {"", ""}}
+	for index, column := range defs[0].Columns {
+		if column.CollationSchema != expects[index][0] || column.Collation != expects[index][1] {
+			t.Errorf("column %s collation got %s.%s, expect %v", column.Name, column.CollationSchema, column.Collation, expects[index])
+		}
+	}
+	if defs[0].Columns[0].Nullable {
+		t.Errorf("code should not be nullable")
+	}
+}
+
+func TestKeywordName(t *testing.T) {
+	tests := []struct {
+		input   string
+		table   string
+		columns []string
+	}{
+		{"CREATE TABLE nulls (nulls INT, UNIQUE NULLS NOT DISTINCT (nulls), EXCLUDE (nulls WITH =, nulls DESC NULLS LAST WITH <>))", "nulls", []string{"nulls"}},
+		{"CREATE TABLE t (of INT, a INT REFERENCES of (of))", "t", []string{"of", "a"}},
+		{"CREATE TABLE of (id INT); CREATE TABLE of_2024 PARTITION OF of DEFAULT", "of", []string{"id"}},
+	}
+	for _, test := range tests {
+		defs, err := ParseTable("keywordName", test.input)
+		if err != nil {
+			t.Errorf("parse %s err :%s", test.input, err)
+			continue
+		}
+		names := []string{}
+		for _, column := range defs[0].Columns {
+			names = append(names, column.Name)
+		}
+		if defs[0].Table != test.table || !reflect.DeepEqual(names, test.columns) {
+			t.Errorf("parse %s got table %s columns %v", test.input, defs[0].Table, names)
+		}
+	}
+
+	// reservedKeywords must follow the keyword categories of the grammar
+	for word, typ := range keywords {
+		_, err := ParseTable("keywordName", "CREATE TABLE "+word+" (id INT)")
+		if reservedKeywords[typ] && err == nil {
+			t.Errorf("reserved keyword %s should not be a table name", word)
+		} else if !reservedKeywords[typ] && err != nil {
+			t.Errorf("keyword %s should be a table name, err :%s", word, err)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unterminated comment", "CREATE TABLE t (id INT); /* no end"},
+		{"bad number", "CREATE TABLE t (id NUMERIC(1a))"},
+		{"two primary keys", "CREATE TABLE t (a INT PRIMARY KEY, b INT, PRIMARY KEY (a, b))"},
+		{"two primary key columns", "CREATE TABLE t (a INT PRIMARY KEY, b INT PRIMARY KEY)"},
+		{"constraint without constraint", "CREATE TABLE t (a INT CONSTRAINT a_key)"},
+		{"two constraint names", "CREATE TABLE t (a INT CONSTRAINT a_key CONSTRAINT b_key UNIQUE)"},
+		{"named collate", "CREATE TABLE t (a TEXT CONSTRAINT x COLLATE \"C\")"},
+		{"exclusion without operator", "CREATE TABLE t (a INT, EXCLUDE (a))"},
+		{"generated by default", "CREATE TABLE t (a INT, b INT GENERATED BY DEFAULT AS (a * 2) STORED)"},
+		{"bad sequence option", "CREATE TABLE t (a INT GENERATED ALWAYS AS IDENTITY (START WITH 1.5))"},
+		{"null and not null", "CREATE TABLE t (a INT NULL DEFAULT 0 NOT NULL)"},
+		{"not null and null", "CREATE TABLE t (a INT NOT NULL NULL)"},
+		{"null identity", "CREATE TABLE t (a INT GENERATED ALWAYS AS IDENTITY NULL)"},
+		{"not deferrable initially deferred", "CREATE TABLE t (a INT UNIQUE NOT DEFERRABLE INITIALLY DEFERRED)"},
+		{"table not deferrable initially deferred", "CREATE TABLE t (a INT, UNIQUE (a) INITIALLY DEFERRED NOT DEFERRABLE)"},
+		{"multiple deferrable", "CREATE TABLE t (a INT PRIMARY KEY DEFERRABLE NOT DEFERRABLE)"},
+		{"multiple initially", "CREATE TABLE t (a INT, UNIQUE (a) INITIALLY DEFERRED INITIALLY IMMEDIATE)"},
+		{"deferrable not null", "CREATE TABLE t (a INT NOT NULL DEFERRABLE)"},
+		{"deferrable check", "CREATE TABLE t (a INT, CHECK (a > 0) DEFERRABLE)"},
+		{"on commit of permanent table", "CREATE TABLE t (a INT) ON COMMIT DROP"},
+		{"unknown partition strategy", "CREATE TABLE t (a INT) PARTITION BY TREE (a)"},
+		{"list partition of two columns", "CREATE TABLE t (a INT, b INT) PARTITION BY LIST (a, b)"},
+		{"hash bound without remainder", "CREATE TABLE t PARTITION OF p FOR VALUES WITH (MODULUS 4)"},
+		{"hash remainder too large", "CREATE TABLE t PARTITION OF p FOR VALUES WITH (MODULUS 4, REMAINDER 4)"},
+		{"partition without bound", "CREATE TABLE t PARTITION OF p"},
+		{"check without parens", "CREATE TABLE t (a INT CHECK a > 0)"},
+		{"foreign key without references", "CREATE TABLE t (a INT, FOREIGN KEY (a))"},
+		{"with oids", "CREATE TABLE t (a INT) WITH OIDS"},
+		{"storage parameters without parens", "CREATE TABLE t (a INT) WITH fillfactor = 70"},
+		{"tablespace before with", "CREATE TABLE t (a INT) TABLESPACE s WITH (fillfactor = 70)"},
+		{"create table as without query", "CREATE TABLE t AS"},
+		{"create table as with column types", "CREATE TABLE t (a INT) AS SELECT 1"},
+		{"select into without table", "SELECT 1 INTO"},
+		{"select into reserved keyword", "SELECT 1 INTO FROM t"},
+		{"column primary key twice", "CREATE TABLE t (a INT PRIMARY KEY PRIMARY KEY)"},
+		{"column default twice", "CREATE TABLE t (a INT DEFAULT 1 NOT NULL DEFAULT 2)"},
+		{"column not null twice", "CREATE TABLE t (a INT NOT NULL NOT NULL)"},
+		{"column null twice", "CREATE TABLE t (a INT NULL NULL)"},
+		{"typed column default twice", "CREATE TABLE t OF typ (a WITH OPTIONS DEFAULT 1 DEFAULT 2)"},
+		{"default and identity", "CREATE TABLE t (a INT DEFAULT 1 GENERATED ALWAYS AS IDENTITY)"},
+		{"identity and default", "CREATE TABLE t (a INT GENERATED BY DEFAULT AS IDENTITY DEFAULT 1)"},
+		{"identity and generation expression", "CREATE TABLE t (a INT GENERATED ALWAYS AS IDENTITY GENERATED ALWAYS AS (1) STORED)"},
+		{"default and generation expression", "CREATE TABLE t (a INT GENERATED ALWAYS AS (1) STORED DEFAULT 2)"},
+		{"foreign table without server", "CREATE FOREIGN TABLE t (a INT)"},
+		{"foreign table primary key", "CREATE FOREIGN TABLE t (a INT PRIMARY KEY) SERVER s"},
+		{"foreign table unique", "CREATE FOREIGN TABLE t (a INT, UNIQUE (a)) SERVER s"},
+		{"duplicate foreign option", "CREATE FOREIGN TABLE t (a INT) SERVER s OPTIONS (a 'x', A 'y')"},
+		{"foreign option without value", "CREATE FOREIGN TABLE t (a INT OPTIONS (column_name)) SERVER s"},
+	}
+	for _, test := range tests {
+		if _, err := ParseTable(test.name, test.input); err == nil {
+			t.Errorf("parse %s should fail", test.name)
+		} else {
+			t.Logf("parse %s err: %s", test.name, err)
+		}
+	}
+}
+
+func TestErrorPosition(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"CREATE TABLE t (\n\tid NUMERIC(1a))", "line:2 column:13"},
+		{"CREATE TABLE t (id INT);\n/* no end", "line:2 column:1"},
+		{"CREATE TABLE t (\n\tid INT,\n\tEXCLUDE (id))", "line:3 column:13"},
+	}
+	for _, test := range tests {
+		_, err := ParseTable("t", test.input)
+		if err == nil {
+			t.Errorf("parse %q should fail", test.input)
+		} else if !strings.HasSuffix(err.Error(), test.expect) {
+			t.Errorf("parse %q err got %s, expect it near %s", test.input, err, test.expect)
+		}
+	}
+}
+
+func TestConstraintAttributeError(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect string
+	}{
+		{"CREATE TABLE t (a INT, UNIQUE (a) DEFERRABLE NOT DEFERRABLE)", "column:46"},
+		{"CREATE TABLE t (a INT, UNIQUE (a) NOT DEFERRABLE INITIALLY DEFERRED)", "column:50"},
+		{"CREATE TABLE t (a INT, CHECK (a > 0) INITIALLY IMMEDIATE DEFERRABLE)", "column:58"},
+		{"CREATE TABLE t (a INT UNIQUE DEFERRABLE DEFERRABLE)", "column:41"},
+	}
+	for _, test := range tests {
+		_, err := ParseTable("t", test.input)
+		if err == nil {
+			t.Errorf("parse %s should fail", test.input)
+		} else if !strings.HasSuffix(err.Error(), test.expect) {
+			t.Errorf("parse %s err got %s, expect it near %s", test.input, err, test.expect)
+		}
+	}
+}
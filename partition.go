@@ -0,0 +1,110 @@
+package tableParser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//PartitionStrategy how the rows of a partitioned table are divided into partitions
+type PartitionStrategy string
+
+//strategies of PARTITION BY
+const (
+	PartitionRange PartitionStrategy = "RANGE"
+	PartitionList  PartitionStrategy = "LIST"
+	PartitionHash  PartitionStrategy = "HASH"
+)
+
+//PartitionSpec the PARTITION BY clause of a partitioned table
+type PartitionSpec struct {
+	Strategy PartitionStrategy
+	Keys     []*PartitionKey
+}
+
+//PartitionKey a column or an expression of the partition key
+type PartitionKey struct {
+	Column    string // column of the key, empty if the key is an expression
+	Expr      Expr
+	Source    string // source text of Expr
+	Collation string
+	OpClass   string
+}
+
+//PartitionBound the PARTITION OF clause of a partition
+type PartitionBound struct {
+	ParentSchema string
+	Parent       string
+	Default      bool   // FOR VALUES is replaced by DEFAULT
+	In           []Expr // values of FOR VALUES IN
+	From         []Expr // lower bound of FOR VALUES FROM ... TO, MINVALUE and MAXVALUE are ColumnRef
+	To           []Expr // upper bound of FOR VALUES FROM ... TO
+	Modulus      int    // modulus of FOR VALUES WITH, 0 for other bounds
+	Remainder    int
+
+	parentKey TableName // ParentSchema and Parent as postgres looks them up
+}
+
+//newPartitionSpec check the strategy and the number of keys like postgres does
+func (l *lexer) newPartitionSpec(pos Pos, strategy string, keys []*PartitionKey) *PartitionSpec {
+	spec := &PartitionSpec{Strategy: PartitionStrategy(strings.ToUpper(strategy)), Keys: keys}
+	switch spec.Strategy {
+	case PartitionRange, PartitionHash:
+	case PartitionList:
+		if len(keys) > 1 {
+			l.errorAt(pos, "cannot use \"list\" partition strategy with more than one column")
+		}
+	default:
+		l.errorAt(pos, "unrecognized partitioning strategy %q", strategy)
+	}
+	return spec
+}
+
+//setHashBound set the MODULUS or REMAINDER of a hash partition bound
+func (l *lexer) setHashBound(bound *PartitionBound, pos Pos, name, value string) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		l.errorAt(pos, "invalid value %s for hash partition bound", value)
+		return
+	}
+	switch strings.ToLower(name) {
+	case "modulus":
+		if bound.Modulus != -1 {
+			l.errorAt(pos, "modulus for hash partition provided more than once")
+		}
+		bound.Modulus = v
+	case "remainder":
+		if bound.Remainder != -1 {
+			l.errorAt(pos, "remainder for hash partition provided more than once")
+		}
+		bound.Remainder = v
+	default:
+		l.errorAt(pos, "unrecognized hash partition bound specification %q", name)
+	}
+}
+
+//checkHashBound check a complete hash partition bound
+func (l *lexer) checkHashBound(bound *PartitionBound, pos Pos) {
+	switch {
+	case bound.Modulus == -1:
+		l.errorAt(pos, "modulus for hash partition must be specified")
+	case bound.Remainder == -1:
+		l.errorAt(pos, "remainder for hash partition must be specified")
+	case bound.Modulus <= 0:
+		l.errorAt(pos, "modulus for hash partition must be an integer value greater than zero")
+	case bound.Remainder >= bound.Modulus:
+		l.errorAt(pos, "remainder for hash partition must be less than modulus")
+	}
+}
+
+func (b *PartitionBound) String() string {
+	switch {
+	case b.Default:
+		return "DEFAULT"
+	case b.In != nil:
+		return fmt.Sprintf("FOR VALUES IN (%s)", joinExprs(b.In, ", "))
+	case b.From != nil:
+		return fmt.Sprintf("FOR VALUES FROM (%s) TO (%s)", joinExprs(b.From, ", "), joinExprs(b.To, ", "))
+	}
+	return fmt.Sprintf("FOR VALUES WITH (MODULUS %d, REMAINDER %d)", b.Modulus, b.Remainder)
+}
@@ -0,0 +1,148 @@
+package tableParser
+
+import (
+	"strings"
+)
+
+//QueryKind statement which creates a table from a query
+type QueryKind string
+
+//statements creating a table from a query
+const (
+	QueryCreateTableAs    QueryKind = "CREATE TABLE AS"
+	QuerySelectInto       QueryKind = "SELECT INTO"
+	QueryMaterializedView QueryKind = "CREATE MATERIALIZED VIEW"
+)
+
+//TableQuery the query a table is created from, the query itself is not analysed
+type TableQuery struct {
+	Kind    QueryKind
+	Columns []string // column names given after the table name, empty if omitted
+	Text    string   // the query as written, for SELECT INTO the whole statement
+	NoData  bool     // WITH NO DATA is given, the table is created without rows
+}
+
+//scanQuery read the tokens of a query which begins with first up to the end of the statement
+//and return them as one tokenQuery, a trailing WITH [NO] DATA is kept out of the query if withData is true
+func (l *lexer) scanQuery(first token, withData bool) token {
+	tokens := []token{first}
+	depth := 0
+	for t := first; ; {
+		switch t.typ {
+		case tokenLeftParen:
+			depth++
+		case tokenRightParen:
+			depth--
+		}
+		t = l.nextSignificant()
+		if t.typ == tokenEOF || t.typ == tokenError || (t.typ == tokenSemicolon && depth <= 0) {
+			l.pending = append(l.pending, t)
+			break
+		}
+		tokens = append(tokens, t)
+	}
+	if withData {
+		n := len(tokens)
+		switch {
+		case n > 2 && tokens[n-2].typ == tokenWITH && tokens[n-1].typ == tokenDATA:
+			l.pending = append(tokens[n-2:n:n], l.pending...)
+			tokens = tokens[:n-2]
+		case n > 3 && tokens[n-3].typ == tokenWITH && tokens[n-2].typ == tokenNO && tokens[n-1].typ == tokenDATA:
+			l.pending = append(tokens[n-3:n:n], l.pending...)
+			tokens = tokens[:n-3]
+		}
+	}
+	last := tokens[len(tokens)-1]
+	return token{tokenQuery, first.pos, l.input[first.pos:last.end], first.line, last.end}
+}
+
+//selectInto the table created by a SELECT INTO statement which begins at pos, the statement may
+//begin with a WITH clause, nil if it has no INTO clause after the SELECT of the statement
+func (l *lexer) selectInto(pos Pos, queryPos Pos, query string) *TableDefine {
+	tokens := []token{}
+	end := queryPos + Pos(len(query))
+	for _, t := range l.scanned {
+		if t.typ != tokenComment && t.pos >= pos && t.end <= end {
+			tokens = append(tokens, t)
+		}
+	}
+	depth := 0
+	selected := false
+	for i, t := range tokens {
+		switch t.typ {
+		case tokenLeftParen:
+			depth++
+		case tokenRightParen:
+			depth--
+		case tokenSELECT:
+			selected = selected || depth == 0
+		case tokenINTO:
+			if depth != 0 {
+				continue
+			}
+			// like INSERT INTO after a WITH clause
+			if !selected {
+				return nil
+			}
+			def := l.selectIntoTarget(pos, tokens[i+1:])
+			if def != nil {
+				def.Query = &TableQuery{Kind: QuerySelectInto, Text: l.input[pos:end]}
+			}
+			return def
+		}
+	}
+	return nil
+}
+
+//selectIntoTarget the table given by [TEMPORARY | UNLOGGED] [TABLE] name after INTO
+func (l *lexer) selectIntoTarget(pos Pos, tokens []token) *TableDefine {
+	header := tableHeader{}
+	i := 0
+	if i < len(tokens) && (tokens[i].typ == tokenLOCAL || tokens[i].typ == tokenGLOBAL) {
+		i++
+	}
+	if i < len(tokens) {
+		switch tokens[i].typ {
+		case tokenTEMP, tokenTEMPORARY:
+			header.Persistence = PersistenceTemporary
+			i++
+		case tokenUNLOGGED:
+			header.Persistence = PersistenceUnlogged
+			i++
+		}
+	}
+	if i < len(tokens) && tokens[i].typ == tokenTable {
+		i++
+	}
+	names := []string{}
+	keys := []string{}
+	for i < len(tokens) && isName(tokens[i]) {
+		names = append(names, tokens[i].val)
+		keys = append(keys, l.nameKey(tokens[i].pos, tokens[i].val))
+		if i+1 >= len(tokens) || tokens[i+1].typ != tokenDot || len(names) == 2 {
+			break
+		}
+		i += 2
+	}
+	switch len(names) {
+	case 0:
+		l.errorAt(pos, "SELECT INTO needs a table name")
+		return nil
+	case 1:
+		header.Table = names[0]
+		header.key = TableName{Table: keys[0]}
+	default:
+		header.Schema, header.Table = names[0], names[1]
+		header.key = TableName{keys[0], keys[1]}
+	}
+	return l.newTableDefine(pos, header, tableBody{}, tableOptions{})
+}
+
+//isName whether the token can be the name of a table
+func isName(t token) bool {
+	switch t.typ {
+	case tokenString, tokenPgSymbol:
+		return true
+	}
+	return keywords[strings.ToLower(t.val)] == t.typ && !reservedKeywords[t.typ]
+}
@@ -0,0 +1,74 @@
+package tableParser
+
+import (
+	"fmt"
+)
+
+//compositeType a composite type declared by CREATE TYPE name AS (...)
+type compositeType struct {
+	pos        Pos
+	Schema     string
+	Name       string
+	key        TableName // Schema and Name as postgres looks them up
+	attributes []*TableColumn
+}
+
+//resolveTypedTables fill the columns of typed tables from the composite types declared before them,
+//the columns given in the table only add options to the attributes of the type
+func resolveTypedTables(defs []*TableDefine, types []*compositeType) error {
+	for _, def := range defs {
+		if def.OfType == nil {
+			continue
+		}
+		var found *compositeType
+		for _, t := range types {
+			if t.pos < def.pos && t.key == def.ofTypeKey {
+				found = t
+			}
+		}
+		if found == nil {
+			continue
+		}
+		if err := def.resolveType(found); err != nil {
+			return err
+		}
+		def.TypeResolved = true
+	}
+	return nil
+}
+
+func (d *TableDefine) resolveType(t *compositeType) error {
+	options := map[string]*TableColumn{}
+	for _, column := range d.Columns {
+		if options[column.Name] != nil {
+			return fmt.Errorf("column %q specified more than once in table %q", column.Name, d.Table)
+		}
+		options[column.Name] = column
+	}
+	columns := []*TableColumn{}
+	for _, attribute := range t.attributes {
+		column := *attribute
+		if option := options[attribute.Name]; option != nil {
+			column.pos = option.pos
+			column.Nullable = option.Nullable
+			column.Default, column.DefaultExpr = option.Default, option.DefaultExpr
+			column.Identity = option.Identity
+			column.Generated, column.GeneratedExpr, column.GeneratedStored = option.Generated, option.GeneratedExpr, option.GeneratedStored
+			if option.Comment != "" {
+				column.Comment = option.Comment
+			}
+			if option.Collation != "" {
+				column.Collation, column.CollationSchema = option.Collation, option.CollationSchema
+			}
+			delete(options, attribute.Name)
+		}
+		columns = append(columns, &column)
+	}
+	for _, column := range d.Columns {
+		if options[column.Name] != nil {
+			return fmt.Errorf("column %q of table %q does not exist in type %q", column.Name, d.Table, TableName{t.Schema, t.Name})
+		}
+	}
+	d.Columns = columns
+	return nil
+}